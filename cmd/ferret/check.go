@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/linter"
+)
+
+func checkCommand(e *env, args []string) int {
+	fs := newFlagSet(e, "check")
+	config := fs.String("config", "", "path to a YAML or JSON config file (default $"+configEnv+")")
+	format := fs.String("format", formatText, "output format: text or json")
+	strict := fs.Bool("strict", false, "fail on warnings as well as on errors")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	if *format != formatText && *format != formatJSON {
+		fmt.Fprintf(e.stderr, "unknown output format: %s\n", *format)
+
+		return exitUsageError
+	}
+
+	cfg, err := loadConfig(*config)
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	sources, err := readSources(e.stdin, fs.Args())
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	engine := ferret.New()
+	l := linter.New(linter.WithConfig(cfg.Lint))
+	code := exitOK
+	failures := make([]linter.Failure, 0, len(sources))
+
+	for _, src := range sources {
+		if _, err := engine.Compile(src.query); err != nil {
+			fmt.Fprintf(e.stderr, "%s: %s\n", src.name, err)
+			code = worst(code, exitCompileError)
+
+			continue
+		}
+
+		found, err := l.LintFile(src.name, src.query)
+
+		if err != nil {
+			// the query is valid, so it's a problem with the linter configuration
+			fmt.Fprintln(e.stderr, err)
+
+			return exitUsageError
+		}
+
+		for _, f := range found {
+			if f.Severity == linter.SeverityError || *strict {
+				code = worst(code, exitCheckFailed)
+			}
+		}
+
+		failures = append(failures, found...)
+	}
+
+	if *format == formatJSON {
+		err = linter.WriteJSON(e.stdout, failures)
+	} else {
+		err = linter.WriteText(e.stdout, failures)
+	}
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitRuntimeError
+	}
+
+	return code
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MontFerret/ferret"
+)
+
+type compileResult struct {
+	File   string   `json:"file"`
+	Params []string `json:"params,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+func compileCommand(e *env, args []string) int {
+	fs := newFlagSet(e, "compile")
+	format := fs.String("format", formatText, "output format: text or json")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	if *format != formatText && *format != formatJSON {
+		fmt.Fprintf(e.stderr, "unknown output format: %s\n", *format)
+
+		return exitUsageError
+	}
+
+	sources, err := readSources(e.stdin, fs.Args())
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	engine := ferret.New()
+	code := exitOK
+	results := make([]compileResult, 0, len(sources))
+
+	for _, src := range sources {
+		res := compileResult{File: src.name}
+		program, err := engine.Compile(src.query)
+
+		if err != nil {
+			res.Error = err.Error()
+			code = worst(code, exitCompileError)
+		} else {
+			res.Params = program.Params()
+			sort.Strings(res.Params)
+		}
+
+		results = append(results, res)
+	}
+
+	if *format == formatJSON {
+		out, err := json.Marshal(results)
+
+		if err != nil {
+			fmt.Fprintln(e.stderr, err)
+
+			return exitRuntimeError
+		}
+
+		fmt.Fprintln(e.stdout, string(out))
+
+		return code
+	}
+
+	for _, res := range results {
+		if res.Error != "" {
+			fmt.Fprintf(e.stderr, "%s: %s\n", res.File, res.Error)
+		} else if len(res.Params) > 0 {
+			fmt.Fprintf(e.stdout, "%s: ok (params: %s)\n", res.File, strings.Join(res.Params, ", "))
+		} else {
+			fmt.Fprintf(e.stdout, "%s: ok\n", res.File)
+		}
+	}
+
+	return code
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/linter"
+)
+
+// configEnv is the name of an environment variable that points to a config file
+// used when --config is not set.
+const configEnv = "FERRET_CONFIG"
+
+type (
+	CDPConfig struct {
+		Address     string `yaml:"address"`
+		KeepCookies bool   `yaml:"keepCookies"`
+	}
+
+	HTTPConfig struct {
+		MaxRetries  int           `yaml:"maxRetries"`
+		Concurrency int           `yaml:"concurrency"`
+		Timeout     time.Duration `yaml:"timeout"`
+	}
+
+	DriverConfig struct {
+		// Default is a name of a driver used when a query does not specify one.
+		Default   string            `yaml:"default"`
+		Proxy     string            `yaml:"proxy"`
+		UserAgent string            `yaml:"userAgent"`
+		Headers   map[string]string `yaml:"headers"`
+		CDP       CDPConfig         `yaml:"cdp"`
+		HTTP      HTTPConfig        `yaml:"http"`
+	}
+
+	// Config represents a configuration file.
+	// Both YAML and JSON files are supported.
+	Config struct {
+		LogLevel string                 `yaml:"logLevel"`
+		Driver   DriverConfig           `yaml:"driver"`
+		Params   map[string]interface{} `yaml:"params"`
+		Lint     linter.Config          `yaml:"lint"`
+	}
+)
+
+func loadConfig(path string) (Config, error) {
+	cfg := Config{
+		Driver: DriverConfig{
+			Default: http.DriverName,
+		},
+	}
+
+	if path == "" {
+		path = os.Getenv(configEnv)
+	}
+
+	if path == "" {
+		return cfg, nil
+	}
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return cfg, errors.Wrap(err, "read config")
+	}
+
+	if err := yaml.Unmarshal(content, &cfg); err != nil {
+		return cfg, errors.Wrapf(err, "parse config %s", path)
+	}
+
+	return cfg, nil
+}
+
+// newEngine creates an instance with both drivers registered and configured.
+func newEngine(cfg DriverConfig) (*ferret.Instance, error) {
+	cdpOpts := []cdp.Option{
+		cdp.WithAddress(cfg.CDP.Address),
+	}
+	httpOpts := make([]http.Option, 0, 5)
+
+	if cfg.Proxy != "" {
+		cdpOpts = append(cdpOpts, cdp.WithProxy(cfg.Proxy))
+		httpOpts = append(httpOpts, http.WithProxy(cfg.Proxy))
+	}
+
+	if cfg.UserAgent != "" {
+		cdpOpts = append(cdpOpts, cdp.WithUserAgent(cfg.UserAgent))
+		httpOpts = append(httpOpts, http.WithUserAgent(cfg.UserAgent))
+	}
+
+	if len(cfg.Headers) > 0 {
+		headers := drivers.NewHTTPHeaders()
+
+		for name, value := range cfg.Headers {
+			headers.Set(name, value)
+		}
+
+		cdpOpts = append(cdpOpts, cdp.WithHeaders(headers))
+		httpOpts = append(httpOpts, http.WithHeaders(headers))
+	}
+
+	if cfg.CDP.KeepCookies {
+		cdpOpts = append(cdpOpts, cdp.WithKeepCookies())
+	}
+
+	if cfg.HTTP.MaxRetries > 0 {
+		httpOpts = append(httpOpts, http.WithMaxRetries(cfg.HTTP.MaxRetries))
+	}
+
+	if cfg.HTTP.Concurrency > 0 {
+		httpOpts = append(httpOpts, http.WithConcurrency(cfg.HTTP.Concurrency))
+	}
+
+	if cfg.HTTP.Timeout > 0 {
+		httpOpts = append(httpOpts, http.WithTimeout(cfg.HTTP.Timeout))
+	}
+
+	var cdpDefault, httpDefault []drivers.GlobalOption
+
+	switch strings.ToLower(cfg.Default) {
+	case cdp.DriverName:
+		cdpDefault = append(cdpDefault, drivers.AsDefault())
+	case http.DriverName, "":
+		httpDefault = append(httpDefault, drivers.AsDefault())
+	default:
+		return nil, errors.Errorf("unknown driver: %s", cfg.Default)
+	}
+
+	engine := ferret.New()
+
+	if err := engine.Drivers().Register(http.NewDriver(httpOpts...), httpDefault...); err != nil {
+		return nil, err
+	}
+
+	if err := engine.Drivers().Register(cdp.NewDriver(cdpOpts...), cdpDefault...); err != nil {
+		return nil, err
+	}
+
+	return engine, nil
+}
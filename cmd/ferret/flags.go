@@ -0,0 +1,171 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/runtime"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+)
+
+// sharedFlags are flags accepted by every command that compiles or executes queries.
+type sharedFlags struct {
+	config     string
+	driver     string
+	cdp        string
+	proxy      string
+	userAgent  string
+	logLevel   string
+	paramsFile string
+	headers    headersFlag
+	params     paramsFlag
+}
+
+func newFlagSet(e *env, name string) *flag.FlagSet {
+	cmd := commands[name]
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: ferret %s\n\nFlags:\n", cmd.usage)
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// parseFlags parses arguments of a command and returns an exit code if the command should not proceed.
+func parseFlags(fs *flag.FlagSet, args []string) (int, bool) {
+	err := fs.Parse(args)
+
+	if err == nil {
+		return exitOK, true
+	}
+
+	if err == flag.ErrHelp {
+		return exitOK, false
+	}
+
+	return exitUsageError, false
+}
+
+func (s *sharedFlags) register(fs *flag.FlagSet) {
+	s.headers = make(headersFlag)
+	s.params = make(paramsFlag)
+
+	fs.StringVar(&s.config, "config", "", "path to a YAML or JSON config file (default $"+configEnv+")")
+	fs.StringVar(&s.driver, "driver", "", "default driver: http or cdp")
+	fs.StringVar(&s.cdp, "cdp", "", "Chrome DevTools Protocol address")
+	fs.StringVar(&s.proxy, "proxy", "", "proxy server address")
+	fs.StringVar(&s.userAgent, "user-agent", "", "user agent header value")
+	fs.StringVar(&s.logLevel, "log-level", "", "log level (default error)")
+	fs.StringVar(&s.paramsFile, "params-file", "", "path to a YAML or JSON file with query parameters")
+	fs.Var(s.headers, "header", `HTTP header added to every request, e.g. --header "X-Token: secret" (repeatable)`)
+	fs.Var(s.params, "param", "query parameter, e.g. --param limit=10 --param url=https://www.google.com (repeatable)")
+}
+
+// load reads the config file and applies flags on top of it.
+// Flags always take precedence over values from the config file.
+func (s *sharedFlags) load() (Config, error) {
+	cfg, err := loadConfig(s.config)
+
+	if err != nil {
+		return cfg, err
+	}
+
+	if s.driver != "" {
+		cfg.Driver.Default = s.driver
+	}
+
+	if s.cdp != "" {
+		cfg.Driver.CDP.Address = s.cdp
+	}
+
+	if s.proxy != "" {
+		cfg.Driver.Proxy = s.proxy
+	}
+
+	if s.userAgent != "" {
+		cfg.Driver.UserAgent = s.userAgent
+	}
+
+	if s.logLevel != "" {
+		cfg.LogLevel = s.logLevel
+	}
+
+	if cfg.LogLevel == "" {
+		cfg.LogLevel = logging.ErrorLevel.String()
+	}
+
+	if _, err := logging.ParseLevel(cfg.LogLevel); err != nil {
+		return cfg, err
+	}
+
+	if len(s.headers) > 0 && cfg.Driver.Headers == nil {
+		cfg.Driver.Headers = make(map[string]string, len(s.headers))
+	}
+
+	for name, value := range s.headers {
+		cfg.Driver.Headers[name] = value
+	}
+
+	if cfg.Params == nil {
+		cfg.Params = make(map[string]interface{})
+	}
+
+	if s.paramsFile != "" {
+		params, err := loadParamsFile(s.paramsFile)
+
+		if err != nil {
+			return cfg, err
+		}
+
+		for name, value := range params {
+			cfg.Params[name] = value
+		}
+	}
+
+	for name, value := range s.params {
+		cfg.Params[name] = value
+	}
+
+	return cfg, nil
+}
+
+// setup loads the configuration and creates an engine along with runtime options.
+func (s *sharedFlags) setup(stderr io.Writer) (*ferret.Instance, []runtime.Option, error) {
+	cfg, err := s.load()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	engine, err := newEngine(cfg.Driver)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return engine, runtimeOptions(cfg, stderr), nil
+}
+
+func runtimeOptions(cfg Config, stderr io.Writer) []runtime.Option {
+	console := zerolog.ConsoleWriter{
+		Out:        stderr,
+		TimeFormat: "15:04:05.999",
+	}
+
+	if stderr != os.Stderr {
+		console.NoColor = true
+	}
+
+	return []runtime.Option{
+		runtime.WithParams(cfg.Params),
+		runtime.WithLog(console),
+		runtime.WithLogLevel(logging.MustParseLevel(cfg.LogLevel)),
+	}
+}
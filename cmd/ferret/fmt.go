@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MontFerret/ferret/pkg/formatter"
+)
+
+var keywordCases = map[string]formatter.KeywordCase{
+	"upper":    formatter.KeywordCaseUpper,
+	"lower":    formatter.KeywordCaseLower,
+	"preserve": formatter.KeywordCasePreserve,
+}
+
+func fmtCommand(e *env, args []string) int {
+	fs := newFlagSet(e, "fmt")
+	write := fs.Bool("w", false, "write result to the source file instead of standard output")
+	list := fs.Bool("l", false, "list files whose formatting differs")
+	check := fs.Bool("check", false, "do not print anything, fail if any file is not formatted")
+	tabs := fs.Bool("tabs", false, "indent with tabs")
+	indent := fs.Int("indent", len(formatter.DefaultIndent), "number of spaces used for indentation")
+	maxWidth := fs.Int("max-width", formatter.DefaultMaxWidth, "preferred maximum line width")
+	keywords := fs.String("keywords", "upper", "keyword case: upper, lower or preserve")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	keywordCase, found := keywordCases[*keywords]
+
+	if !found {
+		fmt.Fprintf(e.stderr, "unknown keyword case: %s\n", *keywords)
+
+		return exitUsageError
+	}
+
+	setters := []formatter.Option{
+		formatter.WithMaxWidth(*maxWidth),
+		formatter.WithIndent(strings.Repeat(" ", *indent)),
+		formatter.WithKeywordCase(keywordCase),
+	}
+
+	if *tabs {
+		setters = append(setters, formatter.WithTabs())
+	}
+
+	sources, err := readSources(e.stdin, fs.Args())
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	f := formatter.New(setters...)
+	code := exitOK
+
+	for _, src := range sources {
+		out, err := f.Format(src.query)
+
+		if err != nil {
+			fmt.Fprintf(e.stderr, "%s: %s\n", src.name, err)
+			code = worst(code, exitCompileError)
+
+			continue
+		}
+
+		changed := out != src.query
+
+		if *check {
+			if changed {
+				code = worst(code, exitCheckFailed)
+			}
+
+			continue
+		}
+
+		if *list && changed {
+			fmt.Fprintln(e.stdout, src.name)
+		}
+
+		if *write {
+			if src.name == stdinName {
+				fmt.Fprintln(e.stderr, "cannot write formatted standard input, use a file instead")
+
+				return exitUsageError
+			}
+
+			if changed {
+				if err := os.WriteFile(src.name, []byte(out), 0644); err != nil {
+					fmt.Fprintln(e.stderr, err)
+					code = worst(code, exitRuntimeError)
+				}
+			}
+
+			continue
+		}
+
+		if !*list {
+			fmt.Fprint(e.stdout, out)
+		}
+	}
+
+	return code
+}
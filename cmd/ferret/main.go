@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// Exit codes returned by the commands.
+// They are meant to be stable so CI pipelines can rely on them.
+const (
+	exitOK           = 0
+	exitRuntimeError = 1
+	exitUsageError   = 2
+	exitCompileError = 3
+	exitCheckFailed  = 4
+)
+
+type (
+	env struct {
+		stdin  io.Reader
+		stdout io.Writer
+		stderr io.Writer
+	}
+
+	command struct {
+		usage       string
+		description string
+		run         func(e *env, args []string) int
+	}
+)
+
+// version is set at build time via -ldflags "-X main.version=...".
+var version = "dev"
+
+var commands = map[string]command{}
+
+func init() {
+	commands["run"] = command{
+		usage:       "run [flags] [file|dir|-]...",
+		description: "execute queries and print their results",
+		run:         runCommand,
+	}
+	commands["compile"] = command{
+		usage:       "compile [flags] [file|dir|-]...",
+		description: "compile queries without executing them",
+		run:         compileCommand,
+	}
+	commands["check"] = command{
+		usage:       "check [flags] [file|dir|-]...",
+		description: "compile and lint queries",
+		run:         checkCommand,
+	}
+	commands["fmt"] = command{
+		usage:       "fmt [flags] [file|dir|-]...",
+		description: "format queries",
+		run:         fmtCommand,
+	}
+	commands["serve"] = command{
+		usage:       "serve [flags]",
+		description: "execute queries sent over HTTP",
+		run:         serveCommand,
+	}
+	commands["repl"] = command{
+		usage:       "repl [flags]",
+		description: "start an interactive session",
+		run:         replCommand,
+	}
+}
+
+func main() {
+	os.Exit(execute(&env{os.Stdin, os.Stdout, os.Stderr}, os.Args[1:]))
+}
+
+func execute(e *env, args []string) int {
+	if len(args) == 0 {
+		usage(e.stderr)
+
+		return exitUsageError
+	}
+
+	name := args[0]
+
+	switch name {
+	case "help", "-h", "-help", "--help":
+		usage(e.stdout)
+
+		return exitOK
+	case "version", "--version":
+		fmt.Fprintln(e.stdout, version)
+
+		return exitOK
+	}
+
+	cmd, found := commands[name]
+
+	if !found {
+		fmt.Fprintf(e.stderr, "unknown command: %s\n\n", name)
+		usage(e.stderr)
+
+		return exitUsageError
+	}
+
+	return cmd.run(e, args[1:])
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: ferret <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].description)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Exit codes:")
+	fmt.Fprintf(w, "  %d  success\n", exitOK)
+	fmt.Fprintf(w, "  %d  runtime error\n", exitRuntimeError)
+	fmt.Fprintf(w, "  %d  invalid usage or configuration\n", exitUsageError)
+	fmt.Fprintf(w, "  %d  compilation error\n", exitCompileError)
+	fmt.Fprintf(w, "  %d  check failed (lint failures or unformatted files)\n", exitCheckFailed)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'ferret <command> -h' for more information on a command.")
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func execTest(stdin string, args ...string) (int, string, string) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := execute(&env{strings.NewReader(stdin), stdout, stderr}, args)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestCLI(t *testing.T) {
+	Convey("Should fail on unknown commands", t, func() {
+		code, _, stderr := execTest("", "foo")
+
+		So(code, ShouldEqual, exitUsageError)
+		So(stderr, ShouldStartWith, "unknown command: foo")
+	})
+
+	Convey("run", t, func() {
+		Convey("Should execute a query with params", func() {
+			code, stdout, _ := execTest(
+				`RETURN { limit: @max, url: @url }`,
+				"run", "--param", "max=10", "--param", "url=https://www.google.com",
+			)
+
+			So(code, ShouldEqual, exitOK)
+			So(stdout, ShouldEqual, "{\"limit\":10,\"url\":\"https://www.google.com\"}\n")
+		})
+
+		Convey("Should read params from a file and a config", func() {
+			dir := t.TempDir()
+			config := filepath.Join(dir, "ferret.yaml")
+			params := filepath.Join(dir, "params.json")
+
+			So(os.WriteFile(config, []byte("params:\n  a: 1\n  b: 2\n"), 0644), ShouldBeNil)
+			So(os.WriteFile(params, []byte(`{"b": 3, "c": [1, 2]}`), 0644), ShouldBeNil)
+
+			code, stdout, _ := execTest(
+				`RETURN [@a, @b, @c, @d]`,
+				"run", "--config", config, "--params-file", params, "--param", "d=foo", "--format", "yaml",
+			)
+
+			So(code, ShouldEqual, exitOK)
+			So(stdout, ShouldEqual, "- 1\n- 3\n- - 1\n  - 2\n- foo\n")
+		})
+
+		Convey("Should print strings as is in text format", func() {
+			code, stdout, _ := execTest(`RETURN "foo"`, "run", "--format", "text")
+
+			So(code, ShouldEqual, exitOK)
+			So(stdout, ShouldEqual, "foo\n")
+		})
+
+		Convey("Should return distinct exit codes", func() {
+			code, _, _ := execTest(`RETURN`, "run")
+			So(code, ShouldEqual, exitCompileError)
+
+			code, _, _ = execTest(`RETURN T::FAIL("oops")`, "run")
+			So(code, ShouldEqual, exitRuntimeError)
+
+			code, _, _ = execTest(`RETURN 1`, "run", "--param", "foo")
+			So(code, ShouldEqual, exitUsageError)
+
+			code, _, _ = execTest(`RETURN 1`, "run", "--format", "xml")
+			So(code, ShouldEqual, exitUsageError)
+		})
+	})
+
+	Convey("compile", t, func() {
+		code, stdout, _ := execTest(`RETURN @foo`, "compile")
+
+		So(code, ShouldEqual, exitOK)
+		So(stdout, ShouldEqual, "<stdin>: ok (params: foo)\n")
+
+		code, stdout, _ = execTest(`RETURN [@c, @a, @d, @b]`, "compile")
+
+		So(code, ShouldEqual, exitOK)
+		So(stdout, ShouldEqual, "<stdin>: ok (params: a, b, c, d)\n")
+
+		code, _, stderr := execTest(`RETURN foo`, "compile")
+
+		So(code, ShouldEqual, exitCompileError)
+		So(stderr, ShouldStartWith, "<stdin>: ")
+	})
+
+	Convey("check", t, func() {
+		code, stdout, _ := execTest("WAIT(1000)\nRETURN NONE", "check")
+
+		So(code, ShouldEqual, exitOK)
+		So(stdout, ShouldStartWith, "<stdin>:1:1: warning:")
+
+		code, _, _ = execTest("WAIT(1000)\nRETURN NONE", "check", "--strict")
+
+		So(code, ShouldEqual, exitCheckFailed)
+
+		config := filepath.Join(t.TempDir(), "ferret.yaml")
+
+		So(os.WriteFile(config, []byte("lint:\n  rules:\n    hardcoded-sleep:\n      severity: error\n"), 0644), ShouldBeNil)
+
+		code, stdout, _ = execTest("WAIT(1000)\nRETURN NONE", "check", "--config", config)
+
+		So(code, ShouldEqual, exitCheckFailed)
+		So(stdout, ShouldStartWith, "<stdin>:1:1: error:")
+	})
+
+	Convey("fmt", t, func() {
+		code, stdout, _ := execTest(`let i = 1 return i`, "fmt")
+
+		So(code, ShouldEqual, exitOK)
+		So(stdout, ShouldEqual, "LET i = 1\nRETURN i\n")
+
+		code, _, _ = execTest(`let i = 1 return i`, "fmt", "--check")
+
+		So(code, ShouldEqual, exitCheckFailed)
+
+		file := filepath.Join(t.TempDir(), "query.fql")
+
+		So(os.WriteFile(file, []byte(`let i = 1 return i`), 0644), ShouldBeNil)
+
+		code, stdout, _ = execTest("", "fmt", "-l", "-w", file)
+
+		So(code, ShouldEqual, exitOK)
+		So(stdout, ShouldEqual, file+"\n")
+
+		content, err := os.ReadFile(file)
+
+		So(err, ShouldBeNil)
+		So(string(content), ShouldEqual, "LET i = 1\nRETURN i\n")
+	})
+}
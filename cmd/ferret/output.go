@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats of query results.
+const (
+	formatJSON   = "json"
+	formatPretty = "pretty"
+	formatYAML   = "yaml"
+	formatText   = "text"
+)
+
+func validateFormat(format string) error {
+	switch format {
+	case formatJSON, formatPretty, formatYAML, formatText:
+		return nil
+	default:
+		return errors.Errorf("unknown output format: %s", format)
+	}
+}
+
+// writeResult writes a JSON encoded query result in a given format.
+func writeResult(w io.Writer, format string, data []byte) error {
+	switch format {
+	case formatPretty:
+		buff := &bytes.Buffer{}
+
+		if err := json.Indent(buff, data, "", "  "); err != nil {
+			return err
+		}
+
+		data = buff.Bytes()
+	case formatYAML:
+		var value interface{}
+
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		out, err := yaml.Marshal(value)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(out)
+
+		return err
+	case formatText:
+		// strings are printed as is, so they can be easily consumed by other tools
+		var str string
+
+		if err := json.Unmarshal(data, &str); err == nil {
+			data = []byte(str)
+		}
+	}
+
+	_, err := fmt.Fprintln(w, string(data))
+
+	return err
+}
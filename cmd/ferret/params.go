@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// paramsFlag collects repeated --param name=value flags.
+// Values are decoded as JSON when possible and treated as plain strings otherwise,
+// so both --param limit=10 and --param url=https://www.google.com work as expected.
+type paramsFlag map[string]interface{}
+
+func (p paramsFlag) String() string {
+	pairs := make([]string, 0, len(p))
+
+	for name, value := range p {
+		out, _ := json.Marshal(value)
+		pairs = append(pairs, name+"="+string(out))
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+func (p paramsFlag) Set(entry string) error {
+	pair := strings.SplitN(entry, "=", 2)
+
+	if len(pair) < 2 || pair[0] == "" {
+		return errors.Errorf("invalid param %q, expected name=value", entry)
+	}
+
+	var value interface{}
+
+	if err := json.Unmarshal([]byte(pair[1]), &value); err != nil {
+		value = pair[1]
+	}
+
+	p[pair[0]] = value
+
+	return nil
+}
+
+// headersFlag collects repeated --header "Name: value" flags.
+type headersFlag map[string]string
+
+func (h headersFlag) String() string {
+	pairs := make([]string, 0, len(h))
+
+	for name, value := range h {
+		pairs = append(pairs, name+": "+value)
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+func (h headersFlag) Set(entry string) error {
+	pair := strings.SplitN(entry, ":", 2)
+
+	if len(pair) < 2 || strings.TrimSpace(pair[0]) == "" {
+		return errors.Errorf("invalid header %q, expected \"Name: value\"", entry)
+	}
+
+	h[strings.TrimSpace(pair[0])] = strings.TrimSpace(pair[1])
+
+	return nil
+}
+
+// loadParamsFile reads query parameters from a JSON or YAML file.
+func loadParamsFile(path string) (map[string]interface{}, error) {
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "read params file")
+	}
+
+	params := make(map[string]interface{})
+
+	if err := yaml.Unmarshal(content, &params); err != nil {
+		return nil, errors.Wrapf(err, "parse params file %s", path)
+	}
+
+	return params, nil
+}
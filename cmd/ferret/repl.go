@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/MontFerret/ferret/pkg/repl"
+)
+
+func replCommand(e *env, args []string) int {
+	var shared sharedFlags
+
+	fs := newFlagSet(e, "repl")
+	shared.register(fs)
+	history := fs.String("history", "", "path to a history file (default ~/.ferret_history)")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	engine, opts, err := shared.setup(e.stderr)
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	setters := []repl.Option{
+		repl.WithRuntimeOptions(opts...),
+	}
+
+	if *history == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			*history = filepath.Join(home, ".ferret_history")
+		}
+	}
+
+	if *history != "" {
+		setters = append(setters, repl.WithHistoryFile(*history))
+	}
+
+	r := repl.New(engine, setters...)
+
+	fmt.Fprintln(e.stdout, "Welcome to Ferret REPL")
+	fmt.Fprintln(e.stdout, "Type :help to list available commands")
+
+	err = r.Run(context.Background(), e.stdin, e.stdout)
+
+	if closeErr := r.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitRuntimeError
+	}
+
+	return exitOK
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+)
+
+func runCommand(e *env, args []string) int {
+	var shared sharedFlags
+
+	fs := newFlagSet(e, "run")
+	shared.register(fs)
+	format := fs.String("format", formatJSON, "output format: json, pretty, yaml or text")
+	timeout := fs.Duration("timeout", 0, "maximum execution time of each query, e.g. 30s (default no timeout)")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	if err := validateFormat(*format); err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	engine, opts, err := shared.setup(e.stderr)
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	sources, err := readSources(e.stdin, fs.Args())
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	code := exitOK
+
+	for _, src := range sources {
+		program, err := engine.Compile(src.query)
+
+		if err != nil {
+			fmt.Fprintf(e.stderr, "%s: %s\n", src.name, err)
+			code = worst(code, exitCompileError)
+
+			continue
+		}
+
+		execCtx, execCancel := ctx, context.CancelFunc(func() {})
+
+		if *timeout > 0 {
+			execCtx, execCancel = context.WithTimeout(ctx, *timeout)
+		}
+
+		out, err := engine.Run(execCtx, program, opts...)
+		execCancel()
+
+		if err != nil {
+			if execCtx.Err() == context.DeadlineExceeded {
+				err = fmt.Errorf("timed out after %s: %w", timeout.Round(time.Millisecond), err)
+			}
+
+			fmt.Fprintf(e.stderr, "%s: %s\n", src.name, err)
+			code = worst(code, exitRuntimeError)
+
+			continue
+		}
+
+		if err := writeResult(e.stdout, *format, out); err != nil {
+			fmt.Fprintf(e.stderr, "%s: %s\n", src.name, err)
+			code = worst(code, exitRuntimeError)
+		}
+	}
+
+	return code
+}
+
+// worst returns an exit code that should be reported when several queries are processed.
+func worst(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
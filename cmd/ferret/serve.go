@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/server"
+)
+
+func serveCommand(e *env, args []string) int {
+	var shared sharedFlags
+
+	fs := newFlagSet(e, "serve")
+	shared.register(fs)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	poolSize := fs.Int("pool-size", server.DefaultPoolSize, "maximum number of queries executed at the same time")
+	cacheSize := fs.Int("cache-size", server.DefaultCacheSize, "maximum number of cached compiled queries, 0 disables caching")
+	timeout := fs.Duration("timeout", 0, "execution timeout used when a request does not set one (default no timeout)")
+	maxTimeout := fs.Duration("max-timeout", 0, "maximum execution timeout a request can set (default no limit)")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	cfg, err := shared.load()
+
+	if err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	// validates the driver configuration before accepting any requests
+	if _, err := newEngine(cfg.Driver); err != nil {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitUsageError
+	}
+
+	srv := server.New(
+		func() (*ferret.Instance, error) {
+			return newEngine(cfg.Driver)
+		},
+		server.WithPoolSize(*poolSize),
+		server.WithCacheSize(*cacheSize),
+		server.WithTimeout(*timeout),
+		server.WithMaxTimeout(*maxTimeout),
+		server.WithRuntimeOptions(runtimeOptions(cfg, e.stderr)...),
+	)
+
+	defer srv.Close()
+
+	httpServer := &http.Server{
+		Addr:    *addr,
+		Handler: srv,
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+
+	go func() {
+		<-signals
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_ = httpServer.Shutdown(ctx)
+	}()
+
+	fmt.Fprintf(e.stderr, "listening on %s\n", *addr)
+
+	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		fmt.Fprintln(e.stderr, err)
+
+		return exitRuntimeError
+	}
+
+	return exitOK
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// stdinName is used as a file name of a query read from standard input.
+const stdinName = "<stdin>"
+
+type source struct {
+	name  string
+	query string
+}
+
+// readSources reads queries from given files and directories.
+// Directories are searched recursively for .fql files.
+// Standard input is used when no paths are given or a path is "-".
+func readSources(stdin io.Reader, paths []string) ([]source, error) {
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	out := make([]source, 0, len(paths))
+
+	for _, path := range paths {
+		if path == "-" {
+			content, err := io.ReadAll(stdin)
+
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, source{stdinName, string(content)})
+
+			continue
+		}
+
+		files, err := collectFiles(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, source{file, string(content)})
+		}
+	}
+
+	return out, nil
+}
+
+func collectFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+
+	err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() && filepath.Ext(file) == ".fql" {
+			files = append(files, file)
+		}
+
+		return nil
+	})
+
+	sort.Strings(files)
+
+	return files, err
+}
@@ -35,6 +35,11 @@ func (i *Instance) Compile(query string) (*runtime.Program, error) {
 	return i.compiler.Compile(query)
 }
 
+// CompileWithVariables compiles a query that may refer to variables declared outside of it.
+func (i *Instance) CompileWithVariables(query string, variables []string) (*runtime.Program, error) {
+	return i.compiler.CompileWithVariables(query, variables)
+}
+
 func (i *Instance) MustCompile(query string) *runtime.Program {
 	return i.compiler.MustCompile(query)
 }
@@ -71,6 +76,29 @@ func (i *Instance) Run(ctx context.Context, program *runtime.Program, opts ...ru
 	return program.Run(ctx, opts...)
 }
 
+// Stream runs a given program and passes elements of its result to a given function one by one.
+// See runtime.Program.Stream for details.
+func (i *Instance) Stream(ctx context.Context, program *runtime.Program, emit func(element []byte) error, opts ...runtime.Option) error {
+	if program == nil {
+		return core.Error(core.ErrInvalidArgument, "program")
+	}
+
+	ctx = i.drivers.WithContext(ctx)
+
+	return program.Stream(ctx, emit, opts...)
+}
+
+// RunInScope runs a given program in a given scope without closing it afterwards.
+func (i *Instance) RunInScope(ctx context.Context, program *runtime.Program, scope *core.Scope, opts ...runtime.Option) ([]byte, error) {
+	if program == nil {
+		return nil, core.Error(core.ErrInvalidArgument, "program")
+	}
+
+	ctx = i.drivers.WithContext(ctx)
+
+	return program.RunInScope(ctx, scope, opts...)
+}
+
 func (i *Instance) MustRun(ctx context.Context, program *runtime.Program, opts ...runtime.Option) []byte {
 	out, err := i.Run(ctx, program, opts...)
 
@@ -34,6 +34,16 @@ func New(setters ...Option) *Compiler {
 }
 
 func (c *Compiler) Compile(query string) (program *runtime.Program, err error) {
+	return c.compile(query, nil)
+}
+
+// CompileWithVariables compiles a query that may refer to variables declared outside of it.
+// Such programs are meant to be executed by Program.RunInScope with a scope that holds these variables.
+func (c *Compiler) CompileWithVariables(query string, variables []string) (program *runtime.Program, err error) {
+	return c.compile(query, variables)
+}
+
+func (c *Compiler) compile(query string, variables []string) (program *runtime.Program, err error) {
 	if query == "" {
 		return nil, ErrEmptyQuery
 	}
@@ -55,9 +65,9 @@ func (c *Compiler) Compile(query string) (program *runtime.Program, err error) {
 	}()
 
 	p := parser.New(query)
-	p.AddErrorListener(newErrorListener())
+	p.AddErrorListener(parser.NewErrorListener())
 
-	l := newVisitor(query, c.funcs)
+	l := newVisitor(query, c.funcs, variables)
 
 	res := p.Visit(l).(*result)
 
@@ -27,6 +27,7 @@ type (
 		*fql.BaseFqlParserVisitor
 		src   string
 		funcs *core.Functions
+		vars  []string
 	}
 )
 
@@ -39,11 +40,12 @@ const (
 	forScope  = "for"
 )
 
-func newVisitor(src string, funcs *core.Functions) *visitor {
+func newVisitor(src string, funcs *core.Functions, vars []string) *visitor {
 	return &visitor{
 		&fql.BaseFqlParserVisitor{},
 		src,
 		funcs,
+		vars,
 	}
 }
 
@@ -56,6 +58,21 @@ func (v *visitor) VisitProgram(ctx *fql.ProgramContext) interface{} {
 
 		gs := newGlobalScope()
 		rs := newRootScope(gs)
+
+		if len(v.vars) > 0 {
+			// variables declared outside of the query live in a parent scope,
+			// so that the query is still able to redeclare them
+			outer := newRootScope(gs)
+
+			for _, name := range v.vars {
+				if err := outer.SetVariable(name); err != nil {
+					return nil, err
+				}
+			}
+
+			rs = newScope(outer, rs.name)
+		}
+
 		block, err := v.visitBody(ctx.Body().(fql.IBodyContext), rs)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,8 @@
+package drivers
+
+// AccessibilityParams configures extraction of accessibility trees.
+type AccessibilityParams struct {
+	// InterestingOnly indicates whether to prune nodes, which are not interesting for assistive technologies,
+	// like generic containers. Children of pruned nodes are attached to their closest kept ancestor.
+	InterestingOnly bool
+}
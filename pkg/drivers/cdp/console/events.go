@@ -0,0 +1,32 @@
+package console
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/rpcc"
+
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/events"
+)
+
+var (
+	consoleAPICalledEvent = events.New("console_api_called")
+	exceptionThrownEvent  = events.New("exception_thrown")
+)
+
+func createConsoleAPICalledStreamFactory(client *cdp.Client) events.SourceFactory {
+	return events.NewStreamSourceFactory(consoleAPICalledEvent, func(ctx context.Context) (rpcc.Stream, error) {
+		return client.Runtime.ConsoleAPICalled(ctx)
+	}, func(stream rpcc.Stream) (interface{}, error) {
+		return stream.(runtime.ConsoleAPICalledClient).Recv()
+	})
+}
+
+func createExceptionThrownStreamFactory(client *cdp.Client) events.SourceFactory {
+	return events.NewStreamSourceFactory(exceptionThrownEvent, func(ctx context.Context) (rpcc.Stream, error) {
+		return client.Runtime.ExceptionThrown(ctx)
+	}, func(stream rpcc.Stream) (interface{}, error) {
+		return stream.(runtime.ExceptionThrownClient).Recv()
+	})
+}
@@ -0,0 +1,137 @@
+package console
+
+import (
+	"strings"
+
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+func toLevel(messageType string) zerolog.Level {
+	switch messageType {
+	case "error", "assert":
+		return zerolog.ErrorLevel
+	case "warning":
+		return zerolog.WarnLevel
+	case "debug", "trace":
+		return zerolog.DebugLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
+
+func toMessageValue(repl *runtime.ConsoleAPICalledReply) *values.Object {
+	args := values.NewArray(len(repl.Args))
+
+	for i := range repl.Args {
+		args.Push(argValue(&repl.Args[i]))
+	}
+
+	url, line, column := locationOf(repl.StackTrace)
+
+	return values.NewObjectWith(
+		values.NewObjectProperty("type", values.NewString(repl.Type)),
+		values.NewObjectProperty("text", values.NewString(formatArgs(repl.Args))),
+		values.NewObjectProperty("args", args),
+		values.NewObjectProperty("url", values.NewString(url)),
+		values.NewObjectProperty("line", values.NewInt(line)),
+		values.NewObjectProperty("column", values.NewInt(column)),
+	)
+}
+
+func toExceptionValue(repl *runtime.ExceptionThrownReply) *values.Object {
+	details := repl.ExceptionDetails
+
+	return values.NewObjectWith(
+		values.NewObjectProperty("message", values.NewString(exceptionMessage(details))),
+		values.NewObjectProperty("url", values.NewString(exceptionURL(details))),
+		values.NewObjectProperty("line", values.NewInt(details.LineNumber)),
+		values.NewObjectProperty("column", values.NewInt(details.ColumnNumber)),
+	)
+}
+
+// formatArgs joins console call arguments the way browsers print them,
+// i.e. strings as they are and other values by their descriptions.
+func formatArgs(args []runtime.RemoteObject) string {
+	parts := make([]string, 0, len(args))
+
+	for i := range args {
+		arg := &args[i]
+
+		switch {
+		case arg.Type == "string" && len(arg.Value) > 0:
+			parts = append(parts, argValue(arg).String())
+		case len(arg.Value) > 0:
+			parts = append(parts, string(arg.Value))
+		case arg.UnserializableValue != nil:
+			parts = append(parts, string(*arg.UnserializableValue))
+		case arg.Description != nil:
+			parts = append(parts, *arg.Description)
+		default:
+			parts = append(parts, arg.Type)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// argValue returns a value of a console call argument.
+// Non-primitive values are returned by their descriptions.
+func argValue(arg *runtime.RemoteObject) core.Value {
+	if len(arg.Value) > 0 {
+		out, err := values.Unmarshal(arg.Value)
+
+		if err == nil {
+			return out
+		}
+	}
+
+	if arg.UnserializableValue != nil {
+		return values.NewString(string(*arg.UnserializableValue))
+	}
+
+	if arg.Description != nil {
+		return values.NewString(*arg.Description)
+	}
+
+	return values.None
+}
+
+func locationOf(trace *runtime.StackTrace) (string, int, int) {
+	if trace == nil || len(trace.CallFrames) == 0 {
+		return "", 0, 0
+	}
+
+	frame := trace.CallFrames[0]
+
+	return frame.URL, frame.LineNumber, frame.ColumnNumber
+}
+
+func exceptionURL(details runtime.ExceptionDetails) string {
+	if details.URL != nil {
+		return *details.URL
+	}
+
+	url, _, _ := locationOf(details.StackTrace)
+
+	return url
+}
+
+// exceptionMessage returns a description of a thrown value, which includes its stack for errors,
+// or the exception text, if the value is not available.
+func exceptionMessage(details runtime.ExceptionDetails) string {
+	if details.Exception != nil {
+		if details.Exception.Description != nil {
+			return *details.Exception.Description
+		}
+
+		if len(details.Exception.Value) > 0 {
+			return details.Text + " " + formatArgs([]runtime.RemoteObject{*details.Exception})
+		}
+	}
+
+	return details.Text
+}
@@ -0,0 +1,77 @@
+package console
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/rs/zerolog"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+func TestHelpers(t *testing.T) {
+	str := func(value string) *string {
+		return &value
+	}
+
+	Convey(".formatArgs", t, func() {
+		Convey("Should join arguments", func() {
+			nan := runtime.UnserializableValue("NaN")
+
+			out := formatArgs([]runtime.RemoteObject{
+				{Type: "string", Value: json.RawMessage(`"count:"`)},
+				{Type: "number", Value: json.RawMessage(`42`)},
+				{Type: "number", UnserializableValue: &nan},
+				{Type: "object", Description: str("HTMLDivElement")},
+				{Type: "undefined"},
+			})
+
+			So(out, ShouldEqual, "count: 42 NaN HTMLDivElement undefined")
+		})
+	})
+
+	Convey(".argValue", t, func() {
+		Convey("Should return primitive values as they are", func() {
+			So(argValue(&runtime.RemoteObject{Type: "number", Value: json.RawMessage(`42`)}), ShouldEqual, values.NewFloat(42))
+			So(argValue(&runtime.RemoteObject{Type: "boolean", Value: json.RawMessage(`true`)}), ShouldEqual, values.True)
+		})
+
+		Convey("Should return objects by their descriptions", func() {
+			So(argValue(&runtime.RemoteObject{Type: "object", Description: str("Array(2)")}), ShouldEqual, values.NewString("Array(2)"))
+		})
+	})
+
+	Convey(".toLevel", t, func() {
+		So(toLevel("error"), ShouldEqual, zerolog.ErrorLevel)
+		So(toLevel("assert"), ShouldEqual, zerolog.ErrorLevel)
+		So(toLevel("warning"), ShouldEqual, zerolog.WarnLevel)
+		So(toLevel("debug"), ShouldEqual, zerolog.DebugLevel)
+		So(toLevel("log"), ShouldEqual, zerolog.InfoLevel)
+	})
+
+	Convey(".exceptionMessage", t, func() {
+		Convey("Should return an error description", func() {
+			details := runtime.ExceptionDetails{
+				Text:      "Uncaught",
+				Exception: &runtime.RemoteObject{Type: "object", Description: str("Error: boom\n    at <anonymous>:1:7")},
+			}
+
+			So(exceptionMessage(details), ShouldEqual, "Error: boom\n    at <anonymous>:1:7")
+		})
+
+		Convey("Should return a thrown primitive with the exception text", func() {
+			details := runtime.ExceptionDetails{
+				Text:      "Uncaught",
+				Exception: &runtime.RemoteObject{Type: "string", Value: json.RawMessage(`"boom"`)},
+			}
+
+			So(exceptionMessage(details), ShouldEqual, "Uncaught boom")
+		})
+
+		Convey("Should return the exception text without an exception", func() {
+			So(exceptionMessage(runtime.ExceptionDetails{Text: "Uncaught SyntaxError"}), ShouldEqual, "Uncaught SyntaxError")
+		})
+	})
+}
@@ -0,0 +1,183 @@
+package console
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/events"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	rtEvents "github.com/MontFerret/ferret/pkg/runtime/events"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Manager forwards console messages and uncaught exceptions of a page into the logger.
+type Manager struct {
+	mu     sync.Mutex
+	logger zerolog.Logger
+	client *cdp.Client
+	level  zerolog.Level
+	loop   *events.Loop
+	stop   context.CancelFunc
+}
+
+func New(
+	logger zerolog.Logger,
+	client *cdp.Client,
+	options drivers.Console,
+) (*Manager, error) {
+	level := zerolog.NoLevel
+
+	if options.Level != "" {
+		lvl, err := logging.ParseLevel(options.Level)
+
+		if err != nil {
+			return nil, core.Errorf(core.ErrInvalidArgument, "console level: %s", options.Level)
+		}
+
+		level = zerolog.Level(lvl)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m := new(Manager)
+	m.logger = logging.WithName(logger.With(), "page_console").Logger()
+	m.client = client
+	m.level = level
+	m.stop = cancel
+	m.loop = events.NewLoop(
+		createConsoleAPICalledStreamFactory(client),
+		createExceptionThrownStreamFactory(client),
+	)
+
+	m.loop.AddListener(consoleAPICalledEvent, events.Always(m.logMessage))
+	m.loop.AddListener(exceptionThrownEvent, events.Always(m.logException))
+
+	if err := m.loop.Run(ctx); err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.logger.Trace().Msg("closing")
+
+	if m.stop != nil {
+		m.stop()
+		m.stop = nil
+	}
+
+	return nil
+}
+
+// OnConsole returns a stream of console messages of a page.
+func (m *Manager) OnConsole(ctx context.Context) (rtEvents.Stream, error) {
+	m.logger.Trace().Msg("starting to stream console events")
+
+	stream, err := m.client.Runtime.ConsoleAPICalled(ctx)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to open console event stream")
+
+		return nil, err
+	}
+
+	return events.NewEventStream(stream, func(_ context.Context, stream rpcc.Stream) (core.Value, error) {
+		repl, err := stream.(runtime.ConsoleAPICalledClient).Recv()
+
+		if err != nil {
+			m.logger.Trace().Err(err).Msg("failed to read data from console event stream")
+
+			return values.None, nil
+		}
+
+		return toMessageValue(repl), nil
+	}), nil
+}
+
+// OnError returns a stream of uncaught exceptions of a page.
+func (m *Manager) OnError(ctx context.Context) (rtEvents.Stream, error) {
+	m.logger.Trace().Msg("starting to stream error events")
+
+	stream, err := m.client.Runtime.ExceptionThrown(ctx)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to open error event stream")
+
+		return nil, err
+	}
+
+	return events.NewEventStream(stream, func(_ context.Context, stream rpcc.Stream) (core.Value, error) {
+		repl, err := stream.(runtime.ExceptionThrownClient).Recv()
+
+		if err != nil {
+			m.logger.Trace().Err(err).Msg("failed to read data from error event stream")
+
+			return values.None, nil
+		}
+
+		return toExceptionValue(repl), nil
+	}), nil
+}
+
+func (m *Manager) logMessage(_ context.Context, message interface{}) {
+	msg, ok := message.(*runtime.ConsoleAPICalledReply)
+
+	if !ok {
+		m.logger.Error().Msg("failed to cast console api called event")
+
+		return
+	}
+
+	level := m.level
+
+	if level == zerolog.NoLevel {
+		level = toLevel(msg.Type)
+	}
+
+	url, line, column := locationOf(msg.StackTrace)
+
+	m.logger.WithLevel(level).
+		Str("type", msg.Type).
+		Str("url", url).
+		Int("line", line).
+		Int("column", column).
+		Msg(formatArgs(msg.Args))
+}
+
+func (m *Manager) logException(_ context.Context, message interface{}) {
+	msg, ok := message.(*runtime.ExceptionThrownReply)
+
+	if !ok {
+		m.logger.Error().Msg("failed to cast exception thrown event")
+
+		return
+	}
+
+	level := m.level
+
+	if level == zerolog.NoLevel {
+		level = zerolog.ErrorLevel
+	}
+
+	details := msg.ExceptionDetails
+
+	m.logger.WithLevel(level).
+		Str("type", "exception").
+		Str("url", exceptionURL(details)).
+		Int("line", details.LineNumber).
+		Int("column", details.ColumnNumber).
+		Msg(exceptionMessage(details))
+}
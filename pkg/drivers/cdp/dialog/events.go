@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/rpcc"
+
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/events"
+)
+
+var dialogOpeningEvent = events.New("dialog_opening")
+
+func createDialogOpeningStreamFactory(client *cdp.Client) events.SourceFactory {
+	return events.NewStreamSourceFactory(dialogOpeningEvent, func(ctx context.Context) (rpcc.Stream, error) {
+		return client.Page.JavascriptDialogOpening(ctx)
+	}, func(stream rpcc.Stream) (interface{}, error) {
+		return stream.(page.JavascriptDialogOpeningClient).Recv()
+	})
+}
@@ -0,0 +1,180 @@
+package dialog
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/events"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	rtEvents "github.com/MontFerret/ferret/pkg/runtime/events"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Manager handles JavaScript dialogs (alert, confirm, prompt and beforeunload) opened by a page.
+// Unless the action is drivers.DialogActionNone, every dialog gets handled automatically,
+// otherwise the page remains blocked until the dialog is handled explicitly.
+type Manager struct {
+	mu      sync.Mutex
+	logger  zerolog.Logger
+	client  *cdp.Client
+	options drivers.Dialog
+	loop    *events.Loop
+	stop    context.CancelFunc
+}
+
+func New(
+	logger zerolog.Logger,
+	client *cdp.Client,
+	options drivers.Dialog,
+) (*Manager, error) {
+	if !drivers.IsDialogActionValid(string(options.Action)) {
+		return nil, core.Errorf(core.ErrInvalidArgument, "dialog action: %s", options.Action)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m := new(Manager)
+	m.logger = logging.WithName(logger.With(), "dialog_manager").Logger()
+	m.client = client
+	m.options = options
+	m.stop = cancel
+	m.loop = events.NewLoop(
+		createDialogOpeningStreamFactory(client),
+	)
+
+	if options.Action != drivers.DialogActionNone {
+		m.loop.AddListener(dialogOpeningEvent, events.Always(m.handleDialog))
+	}
+
+	if err := m.loop.Run(ctx); err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.logger.Trace().Msg("closing")
+
+	if m.stop != nil {
+		m.stop()
+		m.stop = nil
+	}
+
+	return nil
+}
+
+// Handle accepts or dismisses a currently open dialog.
+// A given prompt text is used only by accepted prompt dialogs.
+func (m *Manager) Handle(ctx context.Context, accept bool, promptText string) error {
+	args := page.NewHandleJavaScriptDialogArgs(accept)
+
+	if accept && promptText != "" {
+		args.SetPromptText(promptText)
+	}
+
+	m.logger.Trace().
+		Bool("accept", accept).
+		Msg("handling dialog")
+
+	if err := m.client.Page.HandleJavaScriptDialog(ctx, args); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to handle dialog")
+
+		return errors.Wrap(err, "handle dialog")
+	}
+
+	m.logger.Trace().Msg("succeeded to handle dialog")
+
+	return nil
+}
+
+// OnDialog returns a stream of dialogs opened by a page.
+func (m *Manager) OnDialog(ctx context.Context) (rtEvents.Stream, error) {
+	m.logger.Trace().Msg("starting to stream dialog events")
+
+	stream, err := m.client.Page.JavascriptDialogOpening(ctx)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to open dialog event stream")
+
+		return nil, err
+	}
+
+	return events.NewEventStream(stream, func(_ context.Context, stream rpcc.Stream) (core.Value, error) {
+		repl, err := stream.(page.JavascriptDialogOpeningClient).Recv()
+
+		if err != nil {
+			m.logger.Trace().Err(err).Msg("failed to read data from dialog event stream")
+
+			return values.None, nil
+		}
+
+		return toValue(repl), nil
+	}), nil
+}
+
+func (m *Manager) handleDialog(ctx context.Context, message interface{}) {
+	msg, ok := message.(*page.JavascriptDialogOpeningReply)
+
+	if !ok {
+		m.logger.Error().Msg("failed to cast dialog opening event")
+
+		return
+	}
+
+	accept := m.options.Action == drivers.DialogActionAccept ||
+		(m.options.Action == drivers.DialogActionDefault && msg.Type == page.DialogTypeBeforeunload)
+
+	var promptText string
+
+	if msg.Type == page.DialogTypePrompt {
+		promptText = m.options.PromptText
+
+		if promptText == "" && msg.DefaultPrompt != nil {
+			promptText = *msg.DefaultPrompt
+		}
+	}
+
+	m.logger.Trace().
+		Str("type", msg.Type.String()).
+		Str("url", msg.URL).
+		Str("message", msg.Message).
+		Bool("accept", accept).
+		Msg("received dialog opening event")
+
+	if err := m.Handle(ctx, accept, promptText); err != nil {
+		m.logger.Error().
+			Err(err).
+			Str("type", msg.Type.String()).
+			Str("url", msg.URL).
+			Msg("failed to handle dialog automatically")
+	}
+}
+
+func toValue(repl *page.JavascriptDialogOpeningReply) *values.Object {
+	defaultPrompt := values.EmptyString
+
+	if repl.DefaultPrompt != nil {
+		defaultPrompt = values.NewString(*repl.DefaultPrompt)
+	}
+
+	return values.NewObjectWith(
+		values.NewObjectProperty("type", values.NewString(repl.Type.String())),
+		values.NewObjectProperty("message", values.NewString(repl.Message)),
+		values.NewObjectProperty("url", values.NewString(repl.URL)),
+		values.NewObjectProperty("defaultPrompt", defaultPrompt),
+	)
+}
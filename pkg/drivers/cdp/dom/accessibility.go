@@ -0,0 +1,259 @@
+package dom
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/accessibility"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+type axTree struct {
+	nodes     map[accessibility.AXNodeID]*accessibility.AXNode
+	backend   map[dom.BackendNodeID]*accessibility.AXNode
+	root      *accessibility.AXNode
+	filtering bool
+}
+
+var (
+	// controlRoles are roles of interactive nodes, which are always kept in pruned trees.
+	controlRoles = map[string]bool{
+		"button":             true,
+		"checkbox":           true,
+		"colorwell":          true,
+		"combobox":           true,
+		"DisclosureTriangle": true,
+		"listbox":            true,
+		"menu":               true,
+		"menubar":            true,
+		"menuitem":           true,
+		"menuitemcheckbox":   true,
+		"menuitemradio":      true,
+		"radio":              true,
+		"scrollbar":          true,
+		"searchbox":          true,
+		"slider":             true,
+		"spinbutton":         true,
+		"switch":             true,
+		"tab":                true,
+		"textbox":            true,
+		"tree":               true,
+		"treeitem":           true,
+	}
+
+	// leafRoles are roles of nodes, whose children are not interesting in pruned trees.
+	leafRoles = map[string]bool{
+		"doc-cover":       true,
+		"graphics-symbol": true,
+		"img":             true,
+		"InlineTextBox":   true,
+		"LineBreak":       true,
+		"meter":           true,
+		"progressbar":     true,
+		"scrollbar":       true,
+		"separator":       true,
+		"slider":          true,
+		"StaticText":      true,
+	}
+
+	// relationTypes are types of properties, which refer to other nodes and are not included into node states.
+	relationTypes = map[accessibility.AXValueType]bool{
+		accessibility.AXValueTypeIDRef:       true,
+		accessibility.AXValueTypeIDRefList:   true,
+		accessibility.AXValueTypeNode:        true,
+		accessibility.AXValueTypeNodeList:    true,
+		accessibility.AXValueTypeDOMRelation: true,
+	}
+)
+
+// GetAccessibilityTree returns an accessibility tree of the main frame.
+// If an element id is given, the tree is returned for that element only.
+func (m *Manager) GetAccessibilityTree(
+	ctx context.Context,
+	id *runtime.RemoteObjectID,
+	params drivers.AccessibilityParams,
+) (*values.Object, error) {
+	repl, err := m.client.Accessibility.GetFullAXTree(ctx, accessibility.NewGetFullAXTreeArgs())
+
+	if err != nil {
+		return nil, errors.Wrap(err, "get accessibility tree")
+	}
+
+	tree := newAXTree(repl.Nodes, params.InterestingOnly)
+	root := tree.root
+
+	if id != nil {
+		node, err := m.client.DOM.DescribeNode(ctx, dom.NewDescribeNodeArgs().SetObjectID(*id))
+
+		if err != nil {
+			return nil, errors.Wrap(err, "describe element")
+		}
+
+		root = tree.backend[node.Node.BackendNodeID]
+	}
+
+	if root == nil {
+		return nil, core.Error(core.ErrNotFound, "accessibility node")
+	}
+
+	// the root node is always returned, even if it is not interesting
+	return tree.toObject(root, tree.collectChildren(root, isControlNode(root))), nil
+}
+
+func newAXTree(nodes []accessibility.AXNode, filtering bool) *axTree {
+	tree := &axTree{
+		nodes:     make(map[accessibility.AXNodeID]*accessibility.AXNode, len(nodes)),
+		backend:   make(map[dom.BackendNodeID]*accessibility.AXNode, len(nodes)),
+		filtering: filtering,
+	}
+
+	for i := range nodes {
+		node := &nodes[i]
+
+		tree.nodes[node.NodeID] = node
+
+		if node.BackendDOMNodeID != nil {
+			tree.backend[*node.BackendDOMNodeID] = node
+		}
+
+		if tree.root == nil && node.ParentID == nil {
+			tree.root = node
+		}
+	}
+
+	return tree
+}
+
+func (t *axTree) collect(node *accessibility.AXNode, insideControl bool) []core.Value {
+	children := t.collectChildren(node, insideControl || isControlNode(node))
+
+	if !t.filtering {
+		return []core.Value{t.toObject(node, children)}
+	}
+
+	if !isInterestingNode(node, insideControl) {
+		// children of pruned nodes are attached to the closest kept ancestor
+		return children
+	}
+
+	if isLeafNode(node) {
+		children = nil
+	}
+
+	return []core.Value{t.toObject(node, children)}
+}
+
+func (t *axTree) collectChildren(node *accessibility.AXNode, insideControl bool) []core.Value {
+	children := make([]core.Value, 0, len(node.ChildIDs))
+
+	for _, childID := range node.ChildIDs {
+		child, found := t.nodes[childID]
+
+		if found {
+			children = append(children, t.collect(child, insideControl)...)
+		}
+	}
+
+	return children
+}
+
+func (t *axTree) toObject(node *accessibility.AXNode, children []core.Value) *values.Object {
+	obj := values.NewObjectWith(
+		values.NewObjectProperty("role", values.NewString(axString(node.Role))),
+		values.NewObjectProperty("name", values.NewString(axString(node.Name))),
+		values.NewObjectProperty("ignored", values.NewBoolean(node.Ignored)),
+	)
+
+	if node.Value != nil {
+		obj.Set("value", axValue(node.Value))
+	}
+
+	if node.Description != nil {
+		obj.Set("description", values.NewString(axString(node.Description)))
+	}
+
+	states := values.NewObject()
+
+	for _, prop := range node.Properties {
+		if !relationTypes[prop.Value.Type] {
+			states.Set(values.NewString(string(prop.Name)), axValue(&prop.Value))
+		}
+	}
+
+	obj.Set("states", states)
+	obj.Set("children", values.NewArrayWith(children...))
+
+	return obj
+}
+
+func isInterestingNode(node *accessibility.AXNode, insideControl bool) bool {
+	if node.Ignored || axBoolProperty(node, accessibility.AXPropertyNameHidden) {
+		return false
+	}
+
+	if axBoolProperty(node, accessibility.AXPropertyNameFocusable) || isControlNode(node) {
+		return true
+	}
+
+	if insideControl {
+		return false
+	}
+
+	return isLeafNode(node) && axString(node.Name) != ""
+}
+
+func isControlNode(node *accessibility.AXNode) bool {
+	return controlRoles[axString(node.Role)]
+}
+
+func isLeafNode(node *accessibility.AXNode) bool {
+	if len(node.ChildIDs) == 0 {
+		return true
+	}
+
+	role := axString(node.Role)
+
+	if leafRoles[role] {
+		return true
+	}
+
+	// named headings are read as a whole
+	return role == "heading" && axString(node.Name) != ""
+}
+
+func axBoolProperty(node *accessibility.AXNode, name accessibility.AXPropertyName) bool {
+	for _, prop := range node.Properties {
+		if prop.Name == name {
+			return values.ToBoolean(axValue(&prop.Value)) == values.True
+		}
+	}
+
+	return false
+}
+
+func axString(value *accessibility.AXValue) string {
+	if str, ok := axValue(value).(values.String); ok {
+		return str.String()
+	}
+
+	return ""
+}
+
+func axValue(value *accessibility.AXValue) core.Value {
+	if value == nil || len(value.Value) == 0 {
+		return values.None
+	}
+
+	out, err := values.Unmarshal(value.Value)
+
+	if err != nil {
+		return values.None
+	}
+
+	return out
+}
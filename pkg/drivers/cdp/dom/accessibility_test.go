@@ -0,0 +1,74 @@
+package dom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mafredri/cdp/protocol/accessibility"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+func Test_axTree(t *testing.T) {
+	str := func(value string) *accessibility.AXValue {
+		raw, _ := json.Marshal(value)
+
+		return &accessibility.AXValue{Type: accessibility.AXValueTypeString, Value: raw}
+	}
+
+	parent := func(id accessibility.AXNodeID) *accessibility.AXNodeID {
+		return &id
+	}
+
+	nodes := []accessibility.AXNode{
+		{NodeID: "1", Role: str("RootWebArea"), Name: str("Page"), ChildIDs: []accessibility.AXNodeID{"2"}},
+		{NodeID: "2", ParentID: parent("1"), Role: str("generic"), ChildIDs: []accessibility.AXNodeID{"3", "5"}},
+		{NodeID: "3", ParentID: parent("2"), Role: str("heading"), Name: str("Title"), ChildIDs: []accessibility.AXNodeID{"4"}},
+		{NodeID: "4", ParentID: parent("3"), Role: str("StaticText"), Name: str("Title")},
+		{
+			NodeID:   "5",
+			ParentID: parent("2"),
+			Role:     str("button"),
+			Name:     str("Submit"),
+			Properties: []accessibility.AXProperty{
+				{Name: accessibility.AXPropertyNameFocusable, Value: accessibility.AXValue{Type: accessibility.AXValueTypeBoolean, Value: json.RawMessage("true")}},
+				{Name: accessibility.AXPropertyNameLabelledby, Value: accessibility.AXValue{Type: accessibility.AXValueTypeNodeList}},
+			},
+		},
+	}
+
+	Convey("axTree", t, func() {
+		Convey("should return all nodes", func() {
+			tree := newAXTree(nodes, false)
+			root := tree.toObject(tree.root, tree.collectChildren(tree.root, false))
+
+			children := root.MustGet("children").(*values.Array)
+
+			So(root.MustGet("role"), ShouldEqual, values.NewString("RootWebArea"))
+			So(children.Length(), ShouldEqual, 1)
+			So(children.Get(0).(*values.Object).MustGet("role"), ShouldEqual, values.NewString("generic"))
+		})
+
+		Convey("should prune not interesting nodes", func() {
+			tree := newAXTree(nodes, true)
+			root := tree.toObject(tree.root, tree.collectChildren(tree.root, false))
+
+			children := root.MustGet("children").(*values.Array)
+
+			So(children.Length(), ShouldEqual, 2)
+
+			heading := children.Get(0).(*values.Object)
+
+			So(heading.MustGet("role"), ShouldEqual, values.NewString("heading"))
+			So(heading.MustGet("children").(*values.Array).Length(), ShouldEqual, 0)
+
+			button := children.Get(1).(*values.Object)
+			states := button.MustGet("states").(*values.Object)
+
+			So(button.MustGet("name"), ShouldEqual, values.NewString("Submit"))
+			So(states.MustGet("focusable"), ShouldEqual, values.True)
+			So(states.Has("labelledby"), ShouldEqual, values.False)
+		})
+	})
+}
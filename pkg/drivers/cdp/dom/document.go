@@ -222,6 +222,10 @@ func (doc *HTMLDocument) MoveMouseByXY(ctx context.Context, x, y values.Float) e
 	return doc.input.MoveMouseByXY(ctx, x, y)
 }
 
+func (doc *HTMLDocument) Evaluate(ctx context.Context, expression values.String, args ...core.Value) (core.Value, error) {
+	return doc.eval.EvalAny(ctx, templates.Eval(expression.String(), eval.EmptyObjectID, args))
+}
+
 func (doc *HTMLDocument) WaitForElement(ctx context.Context, selector drivers.QuerySelector, when drivers.WaitEvent) error {
 	task := events.NewEvalWaitTask(
 		doc.eval,
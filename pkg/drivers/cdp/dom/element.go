@@ -420,6 +420,18 @@ func (el *HTMLElement) WaitForClassBySelectorAll(ctx context.Context, selector d
 	return err
 }
 
+func (el *HTMLElement) Evaluate(ctx context.Context, expression values.String, args ...core.Value) (core.Value, error) {
+	return el.eval.EvalAny(ctx, templates.Eval(expression.String(), el.id, args))
+}
+
+func (el *HTMLElement) CaptureScreenshot(ctx context.Context, params drivers.ScreenshotParams) (values.Binary, error) {
+	return el.dom.CaptureScreenshot(ctx, &el.id, params)
+}
+
+func (el *HTMLElement) GetAccessibilityTree(ctx context.Context, params drivers.AccessibilityParams) (*values.Object, error) {
+	return el.dom.GetAccessibilityTree(ctx, &el.id, params)
+}
+
 func (el *HTMLElement) WaitForAttribute(
 	ctx context.Context,
 	name values.String,
@@ -526,6 +538,40 @@ func (el *HTMLElement) ClickBySelectorAll(ctx context.Context, selector drivers.
 	return err
 }
 
+func (el *HTMLElement) ClickWithButton(ctx context.Context, button drivers.MouseButton, count values.Int) error {
+	return el.input.ClickWithButton(ctx, el.id, button, int(count))
+}
+
+func (el *HTMLElement) DragTo(ctx context.Context, target drivers.HTMLElement) error {
+	other, ok := target.(*HTMLElement)
+
+	if !ok {
+		return core.Error(core.ErrInvalidArgument, "drag target must belong to the same page")
+	}
+
+	return el.input.Drag(ctx, el.id, other.id)
+}
+
+func (el *HTMLElement) Wheel(ctx context.Context, deltaX, deltaY values.Float) error {
+	return el.input.Wheel(ctx, el.id, float64(deltaX), float64(deltaY))
+}
+
+func (el *HTMLElement) Hold(ctx context.Context, duration values.Int) error {
+	return el.input.Hold(ctx, el.id, time.Duration(duration)*time.Millisecond)
+}
+
+func (el *HTMLElement) Tap(ctx context.Context) error {
+	return el.input.Tap(ctx, el.id)
+}
+
+func (el *HTMLElement) Swipe(ctx context.Context, deltaX, deltaY values.Float) error {
+	return el.input.Swipe(ctx, el.id, float64(deltaX), float64(deltaY))
+}
+
+func (el *HTMLElement) Pinch(ctx context.Context, scale values.Float) error {
+	return el.input.Pinch(ctx, el.id, float64(scale))
+}
+
 func (el *HTMLElement) Input(ctx context.Context, value core.Value, delay values.Int) error {
 	name, err := el.GetNodeName(ctx)
 
@@ -568,6 +614,26 @@ func (el *HTMLElement) ClearBySelector(ctx context.Context, selector drivers.Que
 	return el.input.ClearBySelector(ctx, el.id, selector)
 }
 
+func (el *HTMLElement) SetFiles(ctx context.Context, files *values.Array) error {
+	paths, err := el.dom.resolveFiles(files)
+
+	if err != nil {
+		return err
+	}
+
+	return el.input.SetFiles(ctx, el.id, paths)
+}
+
+func (el *HTMLElement) SetFilesBySelector(ctx context.Context, selector drivers.QuerySelector, files *values.Array) error {
+	paths, err := el.dom.resolveFiles(files)
+
+	if err != nil {
+		return err
+	}
+
+	return el.input.SetFilesBySelector(ctx, el.id, selector, paths)
+}
+
 func (el *HTMLElement) Select(ctx context.Context, value *values.Array) (*values.Array, error) {
 	return el.input.Select(ctx, el.id, value)
 }
@@ -0,0 +1,103 @@
+package dom
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// resolveFiles converts a given list of files to absolute file paths.
+// Strings are treated as paths and binaries get written to temporary files,
+// which are removed once the manager is closed.
+func (m *Manager) resolveFiles(files *values.Array) ([]string, error) {
+	paths := make([]string, 0, files.Length())
+
+	var err error
+
+	files.ForEach(func(value core.Value, idx int) bool {
+		var path string
+
+		switch v := value.(type) {
+		case values.String:
+			path, err = filepath.Abs(v.String())
+
+			if err != nil {
+				return false
+			}
+
+			if _, err = os.Stat(path); err != nil {
+				err = errors.Wrapf(err, "file #%d", idx)
+
+				return false
+			}
+		case values.Binary:
+			path, err = m.writeTempFile(v)
+
+			if err != nil {
+				return false
+			}
+		default:
+			err = errors.Wrapf(core.TypeError(value.Type(), types.String, types.Binary), "file #%d", idx)
+
+			return false
+		}
+
+		paths = append(paths, path)
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+func (m *Manager) writeTempFile(content []byte) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.tempDir == "" {
+		dir, err := os.MkdirTemp("", "ferret-files-")
+
+		if err != nil {
+			return "", errors.Wrap(err, "create temp directory")
+		}
+
+		m.tempDir = dir
+	}
+
+	file, err := os.CreateTemp(m.tempDir, "file-")
+
+	if err != nil {
+		return "", errors.Wrap(err, "create temp file")
+	}
+
+	defer file.Close()
+
+	if _, err := file.Write(content); err != nil {
+		return "", errors.Wrap(err, "write temp file")
+	}
+
+	return file.Name(), nil
+}
+
+func (m *Manager) removeTempFiles() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.tempDir == "" {
+		return nil
+	}
+
+	err := os.RemoveAll(m.tempDir)
+	m.tempDir = ""
+
+	return err
+}
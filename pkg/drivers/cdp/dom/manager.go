@@ -26,6 +26,7 @@ type Manager struct {
 	keyboard  *input.Keyboard
 	mainFrame *AtomicFrameID
 	frames    *AtomicFrameCollection
+	tempDir   string
 }
 
 func New(
@@ -60,6 +61,10 @@ func (m *Manager) Close() error {
 		return true
 	})
 
+	if err := m.removeTempFiles(); err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return core.Errors(errs...)
 	}
@@ -0,0 +1,125 @@
+package dom
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/emulation"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/input"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/utils"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// CaptureScreenshot takes a screenshot of the page.
+// If an element id is given, the screenshot is clipped to the element bounding box.
+func (m *Manager) CaptureScreenshot(
+	ctx context.Context,
+	id *runtime.RemoteObjectID,
+	params drivers.ScreenshotParams,
+) (values.Binary, error) {
+	metrics, err := m.client.Page.GetLayoutMetrics(ctx)
+
+	if err != nil {
+		return values.NewBinary(nil), err
+	}
+
+	if params.Quality < 0 || params.Quality > 100 {
+		params.Quality = 100
+	}
+
+	var clip page.Viewport
+	var beyondViewport bool
+
+	switch {
+	case id != nil:
+		box, err := input.GetBoundingBoxByObjectID(ctx, m.client, *id)
+
+		if err != nil {
+			return values.NewBinary(nil), errors.Wrap(err, "get element bounding box")
+		}
+
+		clip = page.Viewport{
+			X:      box.X,
+			Y:      box.Y,
+			Width:  box.Width,
+			Height: box.Height,
+			Scale:  1.0,
+		}
+		beyondViewport = true
+	case bool(params.FullPage):
+		width, height := utils.GetContentSizeWH(metrics)
+
+		clip = page.Viewport{
+			X:      0,
+			Y:      0,
+			Width:  width,
+			Height: height,
+			Scale:  1.0,
+		}
+		beyondViewport = true
+	default:
+		if params.X < 0 {
+			params.X = 0
+		}
+
+		if params.Y < 0 {
+			params.Y = 0
+		}
+
+		clientWidth, clientHeight := utils.GetLayoutViewportWH(metrics)
+
+		if params.Width <= 0 {
+			params.Width = values.Float(clientWidth) - params.X
+		}
+
+		if params.Height <= 0 {
+			params.Height = values.Float(clientHeight) - params.Y
+		}
+
+		clip = page.Viewport{
+			X:      float64(params.X),
+			Y:      float64(params.Y),
+			Width:  float64(params.Width),
+			Height: float64(params.Height),
+			Scale:  1.0,
+		}
+	}
+
+	if params.OmitBackground {
+		transparent := emulation.NewSetDefaultBackgroundColorOverrideArgs().SetColor(dom.RGBA{R: 0, G: 0, B: 0, A: new(float64)})
+
+		if err := m.client.Emulation.SetDefaultBackgroundColorOverride(ctx, transparent); err != nil {
+			return values.NewBinary(nil), errors.Wrap(err, "override background color")
+		}
+
+		defer func() {
+			// without a color, the override gets reset
+			if err := m.client.Emulation.SetDefaultBackgroundColorOverride(ctx, emulation.NewSetDefaultBackgroundColorOverrideArgs()); err != nil {
+				m.logger.Warn().Err(err).Msg("failed to reset background color")
+			}
+		}()
+	}
+
+	format := string(params.Format)
+	args := page.NewCaptureScreenshotArgs().
+		SetFormat(format).
+		SetClip(clip).
+		SetCaptureBeyondViewport(beyondViewport)
+
+	if params.Format != drivers.ScreenshotFormatPNG {
+		args.SetQuality(int(params.Quality))
+	}
+
+	reply, err := m.client.Page.CaptureScreenshot(ctx, args)
+
+	if err != nil {
+		return values.NewBinary([]byte{}), err
+	}
+
+	return values.NewBinary(reply.Data), nil
+}
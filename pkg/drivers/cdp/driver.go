@@ -24,20 +24,37 @@ var defaultViewport = &drivers.Viewport{
 	Height: 900,
 }
 
-type Driver struct {
-	mu        sync.Mutex
-	dev       *devtool.DevTools
-	conn      *rpcc.Conn
-	client    *cdp.Client
-	session   *session.Manager
-	contextID browser.ContextID
-	options   *Options
-}
+type (
+	Driver struct {
+		mu        sync.Mutex
+		dev       *devtool.DevTools
+		conn      *rpcc.Conn
+		client    *cdp.Client
+		session   *session.Manager
+		contextID browser.ContextID
+		options   *Options
+		launcher  *Launcher
+	}
+
+	// browserConn is a browser connection taken under the driver lock.
+	// It stays valid after the driver gets reset, its calls just fail once the browser is gone.
+	browserConn struct {
+		client    *cdp.Client
+		session   *session.Manager
+		contextID browser.ContextID
+	}
+)
 
 func NewDriver(opts ...Option) *Driver {
 	drv := new(Driver)
 	drv.options = NewOptions(opts)
-	drv.dev = devtool.New(drv.options.Address)
+
+	if drv.options.Launcher != nil {
+		drv.launcher = NewLauncher(*drv.options.Launcher)
+		drv.launcher.onCrash = drv.reset
+	} else {
+		drv.dev = devtool.New(drv.options.Address)
+	}
 
 	return drv
 }
@@ -49,7 +66,7 @@ func (drv *Driver) Name() string {
 func (drv *Driver) Open(ctx context.Context, params drivers.Params) (drivers.HTMLPage, error) {
 	logger := logging.FromContext(ctx)
 
-	conn, err := drv.createConnection(ctx, params.KeepCookies)
+	bc, conn, id, err := drv.createConnection(ctx, params.KeepCookies)
 
 	if err != nil {
 		logger.Error().
@@ -60,13 +77,17 @@ func (drv *Driver) Open(ctx context.Context, params drivers.Params) (drivers.HTM
 		return nil, err
 	}
 
-	return LoadHTMLPage(ctx, conn, drv.setDefaultParams(params))
+	params = drv.setDefaultParams(drivers.SetSessionParams(params))
+
+	return drv.loadPage(ctx, bc, conn, id, params, func() (*HTMLPage, error) {
+		return LoadHTMLPage(ctx, conn, params)
+	})
 }
 
 func (drv *Driver) Parse(ctx context.Context, params drivers.ParseParams) (drivers.HTMLPage, error) {
 	logger := logging.FromContext(ctx)
 
-	conn, err := drv.createConnection(ctx, true)
+	bc, conn, id, err := drv.createConnection(ctx, true)
 
 	if err != nil {
 		logger.Error().
@@ -77,34 +98,121 @@ func (drv *Driver) Parse(ctx context.Context, params drivers.ParseParams) (drive
 		return nil, err
 	}
 
-	return LoadHTMLPageWithContent(ctx, conn, drv.setDefaultParams(drivers.Params{
+	pageParams := drv.setDefaultParams(drivers.Params{
 		URL:         BlankPageURL,
 		UserAgent:   "",
 		KeepCookies: params.KeepCookies,
 		Cookies:     params.Cookies,
 		Headers:     params.Headers,
 		Viewport:    params.Viewport,
-	}), params.Content)
+	})
+
+	return drv.loadPage(ctx, bc, conn, id, pageParams, func() (*HTMLPage, error) {
+		return LoadHTMLPageWithContent(ctx, conn, pageParams, params.Content)
+	})
 }
 
 func (drv *Driver) Close() error {
 	drv.mu.Lock()
 	defer drv.mu.Unlock()
 
+	var err error
+
 	if drv.session != nil {
 		drv.session.Close()
+		err = drv.conn.Close()
+		drv.session = nil
+	}
 
-		return drv.conn.Close()
+	if drv.launcher != nil {
+		if e := drv.launcher.Close(); e != nil && err == nil {
+			err = e
+		}
 	}
 
-	return nil
+	return err
+}
+
+// reset drops the connection to a crashed browser, so that it gets re-established on the next use.
+func (drv *Driver) reset() {
+	drv.mu.Lock()
+	defer drv.mu.Unlock()
+
+	if drv.session == nil {
+		return
+	}
+
+	drv.session.Close()
+	drv.conn.Close()
+	drv.session = nil
+	drv.conn = nil
+	drv.client = nil
 }
 
-func (drv *Driver) createConnection(ctx context.Context, keepCookies bool) (*rpcc.Conn, error) {
-	err := drv.init(ctx)
+// loadPage loads a page of a given target and starts tracking pages opened by it.
+// Child pages inherit the emulation and network params of the parent page.
+func (drv *Driver) loadPage(
+	ctx context.Context,
+	bc browserConn,
+	conn *rpcc.Conn,
+	id target.ID,
+	params drivers.Params,
+	load func() (*HTMLPage, error),
+) (*HTMLPage, error) {
+	logger := logging.FromContext(ctx)
+
+	// tracking starts before loading, so that popups opened during the page load are not missed
+	targets, err := newTargetManager(logger, bc.client, id, func(ctx context.Context, childID target.ID) (*HTMLPage, error) {
+		conn, err := bc.session.Dial(ctx, childID)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "establish a new connection")
+		}
+
+		// child pages are already navigated by the browser
+		childParams := drivers.Params{
+			UserAgent:    params.UserAgent,
+			Viewport:     params.Viewport,
+			Headers:      params.Headers,
+			Ignore:       params.Ignore,
+			Dialog:       params.Dialog,
+			Emulation:    params.Emulation,
+			Intercept:    params.Intercept,
+			HAR:          params.HAR,
+			InputProfile: params.InputProfile,
+		}
+
+		return drv.loadPage(ctx, bc, conn, childID, childParams, func() (*HTMLPage, error) {
+			return LoadHTMLPage(ctx, conn, childParams)
+		})
+	})
 
 	if err != nil {
-		return nil, errors.Wrap(err, "initialize driver")
+		conn.Close()
+
+		return nil, errors.Wrap(err, "track page targets")
+	}
+
+	p, err := load()
+
+	if err != nil {
+		targets.Close()
+
+		return p, err
+	}
+
+	p.mu.Lock()
+	p.targets = targets
+	p.mu.Unlock()
+
+	return p, nil
+}
+
+func (drv *Driver) createConnection(ctx context.Context, keepCookies bool) (browserConn, *rpcc.Conn, target.ID, error) {
+	bc, err := drv.init(ctx)
+
+	if err != nil {
+		return bc, nil, "", errors.Wrap(err, "initialize driver")
 	}
 
 	// Args for a new target belonging to the browser context
@@ -112,27 +220,43 @@ func (drv *Driver) createConnection(ctx context.Context, keepCookies bool) (*rpc
 
 	if !drv.options.KeepCookies && !keepCookies {
 		// Set it to an incognito mode
-		createTargetArgs.SetBrowserContextID(drv.contextID)
+		createTargetArgs.SetBrowserContextID(bc.contextID)
 	}
 
 	// New target
-	createTarget, err := drv.client.Target.CreateTarget(ctx, createTargetArgs)
+	createTarget, err := bc.client.Target.CreateTarget(ctx, createTargetArgs)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "create a browser target")
+		return bc, nil, "", errors.Wrap(err, "create a browser target")
 	}
 
 	// Connect to target using the existing websocket connection.
-	conn, err := drv.session.Dial(ctx, createTarget.TargetID)
+	conn, err := bc.session.Dial(ctx, createTarget.TargetID)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "establish a new connection")
+		return bc, nil, "", errors.Wrap(err, "establish a new connection")
 	}
 
-	return conn, nil
+	return bc, conn, createTarget.TargetID, nil
 }
 
 func (drv *Driver) setDefaultParams(params drivers.Params) drivers.Params {
+	if params.Emulation != nil && params.Emulation.Device != "" {
+		device, found := drivers.GetDevice(params.Emulation.Device)
+
+		// unknown devices get reported by the page loader
+		if found {
+			if params.Viewport == nil {
+				viewport := device.Viewport
+				params.Viewport = &viewport
+			}
+
+			if params.UserAgent == "" {
+				params.UserAgent = device.UserAgent
+			}
+		}
+	}
+
 	if params.Viewport == nil {
 		params.Viewport = defaultViewport
 	}
@@ -140,57 +264,83 @@ func (drv *Driver) setDefaultParams(params drivers.Params) drivers.Params {
 	return drivers.SetDefaultParams(drv.options.Options, params)
 }
 
-func (drv *Driver) init(ctx context.Context) error {
+// init connects to a browser, unless it is already connected, and returns the connection.
+// Connection fields of the driver must not be read outside the lock, since they get dropped on browser crashes.
+func (drv *Driver) init(ctx context.Context) (browserConn, error) {
 	drv.mu.Lock()
 	defer drv.mu.Unlock()
 
 	if drv.session == nil {
-		ver, err := drv.dev.Version(ctx)
+		if err := drv.connect(ctx); err != nil {
+			return browserConn{}, err
+		}
+	}
+
+	return browserConn{
+		client:    drv.client,
+		session:   drv.session,
+		contextID: drv.contextID,
+	}, nil
+}
+
+func (drv *Driver) connect(ctx context.Context) error {
+	if drv.launcher != nil {
+		address, err := drv.launcher.Launch(ctx)
 
 		if err != nil {
-			return errors.Wrap(err, "failed to initialize driver")
+			return errors.Wrap(err, "failed to launch browser")
 		}
 
-		dialOpts := make([]rpcc.DialOption, 0, 2)
+		drv.dev = devtool.New(address)
+	}
 
-		if drv.options.Connection != nil {
-			if drv.options.Connection.BufferSize > 0 {
-				dialOpts = append(dialOpts, rpcc.WithWriteBufferSize(drv.options.Connection.BufferSize))
-			}
+	ver, err := drv.dev.Version(ctx)
 
-			if drv.options.Connection.Compression {
-				dialOpts = append(dialOpts, rpcc.WithCompression())
-			}
-		}
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize driver")
+	}
 
-		bconn, err := rpcc.DialContext(
-			ctx,
-			ver.WebSocketDebuggerURL,
-			dialOpts...,
-		)
+	dialOpts := make([]rpcc.DialOption, 0, 2)
 
-		if err != nil {
-			return errors.Wrap(err, "failed to initialize driver")
+	if drv.options.Connection != nil {
+		if drv.options.Connection.BufferSize > 0 {
+			dialOpts = append(dialOpts, rpcc.WithWriteBufferSize(drv.options.Connection.BufferSize))
 		}
 
-		bc := cdp.NewClient(bconn)
+		if drv.options.Connection.Compression {
+			dialOpts = append(dialOpts, rpcc.WithCompression())
+		}
+	}
 
-		sess, err := session.NewManager(bc)
+	bconn, err := rpcc.DialContext(
+		ctx,
+		ver.WebSocketDebuggerURL,
+		dialOpts...,
+	)
 
-		if err != nil {
-			bconn.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize driver")
+	}
 
-			return errors.Wrap(err, "failed to initialize driver")
-		}
+	bc := cdp.NewClient(bconn)
 
-		drv.conn = bconn
-		drv.client = bc
-		drv.session = sess
+	sess, err := session.NewManager(bc)
 
-		if drv.options.KeepCookies {
-			return nil
-		}
+	if err != nil {
+		bconn.Close()
+
+		return errors.Wrap(err, "failed to initialize driver")
+	}
 
+	// required for tracking tabs and popups opened by pages
+	if err := bc.Target.SetDiscoverTargets(ctx, target.NewSetDiscoverTargetsArgs(true)); err != nil {
+		bconn.Close()
+		sess.Close()
+
+		return errors.Wrap(err, "failed to initialize driver")
+	}
+
+	if !drv.options.KeepCookies {
 		createCtx, err := bc.Target.CreateBrowserContext(ctx, &target.CreateBrowserContextArgs{})
 
 		if err != nil {
@@ -203,5 +353,9 @@ func (drv *Driver) init(ctx context.Context) error {
 		drv.contextID = createCtx.BrowserContextID
 	}
 
+	drv.conn = bconn
+	drv.client = bc
+	drv.session = sess
+
 	return nil
 }
@@ -0,0 +1,148 @@
+package cdp
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/emulation"
+	"github.com/mafredri/cdp/protocol/network"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+const defaultMaxTouchPoints = 5
+
+func emulate(ctx context.Context, client *cdp.Client, params *drivers.Emulation) error {
+	if params == nil {
+		return nil
+	}
+
+	touch := params.Touch
+
+	if params.Device != "" {
+		device, found := drivers.GetDevice(params.Device)
+
+		if !found {
+			return core.Errorf(core.ErrNotFound, "device: %s", params.Device)
+		}
+
+		touch = touch || device.Touch
+	}
+
+	if params.ColorScheme != "" && !drivers.IsColorSchemeValid(string(params.ColorScheme)) {
+		return core.Errorf(core.ErrInvalidArgument, "color scheme: %s", params.ColorScheme)
+	}
+
+	return runBatch(
+		func() error {
+			if params.Geolocation == nil {
+				return nil
+			}
+
+			return client.Emulation.SetGeolocationOverride(
+				ctx,
+				emulation.NewSetGeolocationOverrideArgs().
+					SetLatitude(params.Geolocation.Latitude).
+					SetLongitude(params.Geolocation.Longitude).
+					SetAccuracy(params.Geolocation.Accuracy),
+			)
+		},
+
+		func() error {
+			if params.Timezone == "" {
+				return nil
+			}
+
+			return client.Emulation.SetTimezoneOverride(
+				ctx,
+				emulation.NewSetTimezoneOverrideArgs(params.Timezone),
+			)
+		},
+
+		func() error {
+			if params.Locale == "" {
+				return nil
+			}
+
+			return client.Emulation.SetLocaleOverride(
+				ctx,
+				emulation.NewSetLocaleOverrideArgs().SetLocale(params.Locale),
+			)
+		},
+
+		func() error {
+			features := make([]emulation.MediaFeature, 0, 2)
+
+			if params.ColorScheme != "" {
+				features = append(features, emulation.MediaFeature{
+					Name:  "prefers-color-scheme",
+					Value: string(params.ColorScheme),
+				})
+			}
+
+			if params.ReducedMotion {
+				features = append(features, emulation.MediaFeature{
+					Name:  "prefers-reduced-motion",
+					Value: "reduce",
+				})
+			}
+
+			if len(features) == 0 {
+				return nil
+			}
+
+			return client.Emulation.SetEmulatedMedia(
+				ctx,
+				emulation.NewSetEmulatedMediaArgs().SetFeatures(features),
+			)
+		},
+
+		func() error {
+			if !touch {
+				return nil
+			}
+
+			return client.Emulation.SetTouchEmulationEnabled(
+				ctx,
+				emulation.NewSetTouchEmulationEnabledArgs(true).SetMaxTouchPoints(defaultMaxTouchPoints),
+			)
+		},
+
+		func() error {
+			if params.CPUThrottling <= 1 {
+				return nil
+			}
+
+			return client.Emulation.SetCPUThrottlingRate(
+				ctx,
+				emulation.NewSetCPUThrottlingRateArgs(params.CPUThrottling),
+			)
+		},
+
+		func() error {
+			if params.Network == nil {
+				return nil
+			}
+
+			return client.Network.EmulateNetworkConditions(
+				ctx,
+				network.NewEmulateNetworkConditionsArgs(
+					params.Network.Offline,
+					params.Network.Latency,
+					toThroughput(params.Network.DownloadThroughput),
+					toThroughput(params.Network.UploadThroughput),
+				),
+			)
+		},
+	)
+}
+
+func toThroughput(value float64) float64 {
+	// -1 disables throttling
+	if value <= 0 {
+		return -1
+	}
+
+	return value
+}
@@ -152,6 +152,68 @@ func (r *Resolver) ToElement(ctx context.Context, ref runtime.RemoteObject) (dri
 	return drivers.ToElement(val)
 }
 
+// ToAny converts a given remote object to a value.
+// Unlike ToValue, it does not require the object to be returned by value:
+// nodes are loaded as elements, arrays are converted item by item
+// and other objects are serialized by the remote runtime.
+func (r *Resolver) ToAny(ctx context.Context, ref runtime.RemoteObject) (core.Value, error) {
+	if ref.ObjectID == nil {
+		return r.ToValue(ctx, ref)
+	}
+
+	switch ToRemoteObjectType(ref) {
+	case NullObjectType, UndefinedObjectType:
+		return values.None, nil
+	case NodeObjectType:
+		return r.loadValue(ctx, NodeObjectType, ToRemoteClassName(ref), *ref.ObjectID)
+	case ArrayObjectType:
+		props, err := r.runtime.GetProperties(ctx, runtime.NewGetPropertiesArgs(*ref.ObjectID).SetOwnProperties(true))
+
+		if err != nil {
+			return values.None, err
+		}
+
+		if err := parseRuntimeException(props.ExceptionDetails); err != nil {
+			return values.None, err
+		}
+
+		result := values.NewArray(len(props.Result))
+
+		for _, descr := range props.Result {
+			if !descr.Enumerable || descr.Value == nil {
+				continue
+			}
+
+			el, err := r.ToAny(ctx, *descr.Value)
+
+			if err != nil {
+				return values.None, err
+			}
+
+			result.Push(el)
+		}
+
+		return result, nil
+	default:
+		repl, err := r.runtime.CallFunctionOn(
+			ctx,
+			runtime.NewCallFunctionOnArgs("function() { return this; }").
+				SetObjectID(*ref.ObjectID).
+				SetReturnByValue(true),
+		)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		if err := parseRuntimeException(repl.ExceptionDetails); err != nil {
+			return values.None, err
+		}
+
+		return r.ToValue(ctx, repl.Result)
+	}
+}
+
 func (r *Resolver) ToProperty(
 	ctx context.Context,
 	id runtime.RemoteObjectID,
@@ -112,6 +112,18 @@ func (rt *Runtime) EvalElement(ctx context.Context, fn *Function) (core.Value, e
 	return rt.resolver.ToElement(ctx, ref)
 }
 
+// EvalAny evaluates a given function and converts its result regardless of its type.
+// DOM nodes, including the ones nested in arrays, are returned as elements.
+func (rt *Runtime) EvalAny(ctx context.Context, fn *Function) (core.Value, error) {
+	ref, err := rt.EvalRef(ctx, fn)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return rt.resolver.ToAny(ctx, ref)
+}
+
 func (rt *Runtime) EvalElements(ctx context.Context, fn *Function) (*values.Array, error) {
 	ref, err := rt.EvalRef(ctx, fn)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/mafredri/cdp/protocol/emulation"
 	"github.com/mafredri/cdp/protocol/network"
 	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/performance"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/MontFerret/ferret/pkg/drivers"
@@ -72,6 +73,14 @@ func enableFeatures(ctx context.Context, client *cdp.Client, params drivers.Para
 			return client.Runtime.Enable(ctx)
 		},
 
+		func() error {
+			return client.DOMStorage.Enable(ctx)
+		},
+
+		func() error {
+			return client.Performance.Enable(ctx, performance.NewEnableArgs())
+		},
+
 		func() error {
 			ua := common.GetUserAgent(params.UserAgent)
 
@@ -0,0 +1,292 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/mafredri/cdp/protocol/input"
+	"github.com/mafredri/cdp/protocol/runtime"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+const (
+	// dragSteps is a number of intermediate mouse moves between drag source and target.
+	dragSteps = 10
+	// dragInterceptTimeout is a time to wait for a native drag to start after the mouse moves.
+	dragInterceptTimeout = 100 * time.Millisecond
+	gestureSteps         = 10
+)
+
+func (m *Manager) ClickWithButton(ctx context.Context, objectID runtime.RemoteObjectID, button drivers.MouseButton, count int) error {
+	m.logger.Trace().
+		Str("object_id", string(objectID)).
+		Str("button", string(button)).
+		Msg("starting to click on an element with a button")
+
+	point, err := m.getGesturePoint(ctx, objectID)
+
+	if err != nil {
+		return err
+	}
+
+	delay := time.Duration(drivers.DefaultMouseDelay) * time.Millisecond
+
+	if err := m.mouse.ClickWithButton(ctx, point.X, point.Y, delay, input.MouseButton(button), count); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to click on an element")
+
+		return err
+	}
+
+	m.logger.Trace().Msg("clicked on an element")
+
+	return nil
+}
+
+// Drag drags a source element and drops it on a target element.
+// Both native HTML5 drag-and-drop and mouse event based implementations are supported.
+func (m *Manager) Drag(ctx context.Context, sourceID, targetID runtime.RemoteObjectID) error {
+	m.logger.Trace().
+		Str("source_object_id", string(sourceID)).
+		Str("target_object_id", string(targetID)).
+		Msg("starting to drag an element")
+
+	source, err := m.getGesturePoint(ctx, sourceID)
+
+	if err != nil {
+		return err
+	}
+
+	target, err := GetClickablePointByObjectID(ctx, m.client, targetID)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed calculating target element points")
+
+		return err
+	}
+
+	if err := m.client.Input.SetInterceptDrags(ctx, input.NewSetInterceptDragsArgs(true)); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := m.client.Input.SetInterceptDrags(context.Background(), input.NewSetInterceptDragsArgs(false)); err != nil {
+			m.logger.Trace().Err(err).Msg("failed to disable drag interception")
+		}
+	}()
+
+	onDrag, err := m.client.Input.DragIntercepted(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer onDrag.Close()
+
+	if err := m.mouse.Move(ctx, source.X, source.Y); err != nil {
+		return err
+	}
+
+	if err := m.mouse.Down(ctx, input.MouseButtonLeft); err != nil {
+		return err
+	}
+
+	if err := m.mouse.MoveBySteps(ctx, target.X, target.Y, dragSteps); err != nil {
+		return err
+	}
+
+	select {
+	case <-onDrag.Ready():
+		repl, err := onDrag.Recv()
+
+		if err != nil {
+			return err
+		}
+
+		m.logger.Trace().Msg("native drag started, dropping data")
+
+		for _, typ := range []string{"dragEnter", "dragOver", "drop"} {
+			err := m.client.Input.DispatchDragEvent(ctx, input.NewDispatchDragEventArgs(typ, target.X, target.Y, repl.Data))
+
+			if err != nil {
+				m.logger.Trace().Err(err).Str("type", typ).Msg("failed to dispatch drag event")
+
+				return err
+			}
+		}
+	case <-time.After(dragInterceptTimeout):
+		m.logger.Trace().Msg("native drag did not start, releasing the mouse")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := m.mouse.Up(ctx, input.MouseButtonLeft); err != nil {
+		return err
+	}
+
+	m.logger.Trace().Msg("dragged an element")
+
+	return nil
+}
+
+func (m *Manager) Wheel(ctx context.Context, objectID runtime.RemoteObjectID, deltaX, deltaY float64) error {
+	m.logger.Trace().
+		Str("object_id", string(objectID)).
+		Float64("delta_x", deltaX).
+		Float64("delta_y", deltaY).
+		Msg("starting to scroll an element with the mouse wheel")
+
+	point, err := m.getGesturePoint(ctx, objectID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := m.mouse.Move(ctx, point.X, point.Y); err != nil {
+		return err
+	}
+
+	if err := m.mouse.Wheel(ctx, deltaX, deltaY); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to dispatch mouse wheel event")
+
+		return err
+	}
+
+	m.logger.Trace().Msg("scrolled an element with the mouse wheel")
+
+	return nil
+}
+
+func (m *Manager) Hold(ctx context.Context, objectID runtime.RemoteObjectID, duration time.Duration) error {
+	m.logger.Trace().
+		Str("object_id", string(objectID)).
+		Dur("duration", duration).
+		Msg("starting to press and hold an element")
+
+	point, err := m.getGesturePoint(ctx, objectID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := m.mouse.Move(ctx, point.X, point.Y); err != nil {
+		return err
+	}
+
+	if err := m.mouse.Down(ctx, input.MouseButtonLeft); err != nil {
+		return err
+	}
+
+	select {
+	case <-time.After(duration):
+	case <-ctx.Done():
+		// do not leave the button pressed
+		m.mouse.Up(context.Background(), input.MouseButtonLeft)
+
+		return ctx.Err()
+	}
+
+	if err := m.mouse.Up(ctx, input.MouseButtonLeft); err != nil {
+		return err
+	}
+
+	m.logger.Trace().Msg("released an element")
+
+	return nil
+}
+
+func (m *Manager) Tap(ctx context.Context, objectID runtime.RemoteObjectID) error {
+	m.logger.Trace().
+		Str("object_id", string(objectID)).
+		Msg("starting to tap on an element")
+
+	point, err := m.getGesturePoint(ctx, objectID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := m.touch.Tap(ctx, point.X, point.Y); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to tap on an element")
+
+		return err
+	}
+
+	m.logger.Trace().Msg("tapped on an element")
+
+	return nil
+}
+
+func (m *Manager) Swipe(ctx context.Context, objectID runtime.RemoteObjectID, deltaX, deltaY float64) error {
+	m.logger.Trace().
+		Str("object_id", string(objectID)).
+		Float64("delta_x", deltaX).
+		Float64("delta_y", deltaY).
+		Msg("starting to swipe an element")
+
+	point, err := m.getGesturePoint(ctx, objectID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := m.touch.Swipe(ctx, point.X, point.Y, deltaX, deltaY, gestureSteps); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to swipe an element")
+
+		return err
+	}
+
+	m.logger.Trace().Msg("swiped an element")
+
+	return nil
+}
+
+func (m *Manager) Pinch(ctx context.Context, objectID runtime.RemoteObjectID, scale float64) error {
+	m.logger.Trace().
+		Str("object_id", string(objectID)).
+		Float64("scale", scale).
+		Msg("starting to pinch an element")
+
+	point, err := m.getGesturePoint(ctx, objectID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := m.touch.Pinch(ctx, point.X, point.Y, scale, gestureSteps); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to pinch an element")
+
+		return err
+	}
+
+	m.logger.Trace().Msg("pinched an element")
+
+	return nil
+}
+
+// getGesturePoint scrolls a given element into view and returns its clickable point.
+func (m *Manager) getGesturePoint(ctx context.Context, objectID runtime.RemoteObjectID) (Quad, error) {
+	err := m.ScrollIntoView(ctx, objectID, drivers.ScrollOptions{
+		Behavior: drivers.ScrollBehaviorAuto,
+		Block:    drivers.ScrollVerticalAlignmentCenter,
+		Inline:   drivers.ScrollHorizontalAlignmentCenter,
+	})
+
+	if err != nil {
+		return Quad{}, err
+	}
+
+	m.logger.Trace().Msg("calculating clickable element points")
+
+	point, err := GetClickablePointByObjectID(ctx, m.client, objectID)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed calculating clickable element points")
+
+		return Quad{}, err
+	}
+
+	m.logger.Trace().Float64("x", point.X).Float64("y", point.Y).Msg("calculated clickable element points")
+
+	return point, nil
+}
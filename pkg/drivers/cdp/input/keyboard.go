@@ -22,7 +22,8 @@ type (
 	}
 
 	Keyboard struct {
-		client *cdp.Client
+		client  *cdp.Client
+		profile Profile
 	}
 )
 
@@ -39,8 +40,8 @@ const (
 	KeyboardLocationRight KeyboardLocation = 2
 )
 
-func NewKeyboard(client *cdp.Client) *Keyboard {
-	return &Keyboard{client}
+func NewKeyboard(client *cdp.Client, profile Profile) *Keyboard {
+	return &Keyboard{client, profile}
 }
 
 func (k *Keyboard) Down(ctx context.Context, char string) error {
@@ -60,17 +61,29 @@ func (k *Keyboard) Up(ctx context.Context, char string) error {
 }
 
 func (k *Keyboard) Type(ctx context.Context, text string, delay time.Duration) error {
-	for _, ch := range text {
-		ch := string(ch)
-
-		if err := k.Down(ctx, ch); err != nil {
-			return err
+	for i, ch := range text {
+		if i > 0 {
+			if typeDelay := k.profile.TypeDelay(delay); typeDelay > 0 {
+				time.Sleep(typeDelay)
+			}
 		}
 
-		releaseDelay := randomDuration(int(delay))
-		time.Sleep(releaseDelay)
+		if typo, ok := k.profile.Typo(ch); ok {
+			if err := k.typeChar(ctx, string(typo), delay); err != nil {
+				return err
+			}
+
+			// noticing the typo takes a while
+			time.Sleep(k.profile.TypeDelay(delay * 3))
+
+			if err := k.press(ctx, []string{"Backspace"}, delay); err != nil {
+				return err
+			}
+
+			time.Sleep(k.profile.TypeDelay(delay))
+		}
 
-		if err := k.Up(ctx, ch); err != nil {
+		if err := k.typeChar(ctx, string(ch), delay); err != nil {
 			return err
 		}
 	}
@@ -78,10 +91,20 @@ func (k *Keyboard) Type(ctx context.Context, text string, delay time.Duration) e
 	return nil
 }
 
+func (k *Keyboard) typeChar(ctx context.Context, ch string, delay time.Duration) error {
+	if err := k.Down(ctx, ch); err != nil {
+		return err
+	}
+
+	time.Sleep(k.profile.PressDelay(delay))
+
+	return k.Up(ctx, ch)
+}
+
 func (k *Keyboard) Press(ctx context.Context, keys []string, count int, delay time.Duration) error {
 	for i := 0; i < count; i++ {
 		if i > 0 {
-			downDelay := randomDuration(int(delay))
+			downDelay := k.profile.PressDelay(delay)
 			time.Sleep(downDelay)
 		}
 
@@ -96,7 +119,7 @@ func (k *Keyboard) Press(ctx context.Context, keys []string, count int, delay ti
 func (k *Keyboard) press(ctx context.Context, keys []string, delay time.Duration) error {
 	for i, key := range keys {
 		if i > 0 {
-			downDelay := randomDuration(int(delay))
+			downDelay := k.profile.PressDelay(delay)
 			time.Sleep(downDelay)
 		}
 
@@ -109,7 +132,7 @@ func (k *Keyboard) press(ctx context.Context, keys []string, delay time.Duration
 	}
 
 	for _, key := range keys {
-		upDelay := randomDuration(int(delay))
+		upDelay := k.profile.PressDelay(delay)
 		time.Sleep(upDelay)
 
 		if err := k.client.Input.DispatchKeyEvent(
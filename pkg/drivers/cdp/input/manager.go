@@ -30,6 +30,7 @@ type (
 		exec     *eval.Runtime
 		keyboard *Keyboard
 		mouse    *Mouse
+		touch    *Touch
 	}
 )
 
@@ -48,6 +49,7 @@ func New(
 		exec,
 		keyboard,
 		mouse,
+		NewTouch(client),
 	}
 }
 
@@ -875,3 +877,49 @@ func (m *Manager) SelectBySelector(ctx context.Context, id runtime.RemoteObjectI
 
 	return arr, nil
 }
+
+func (m *Manager) SetFiles(ctx context.Context, objectID runtime.RemoteObjectID, files []string) error {
+	m.logger.Trace().
+		Str("object_id", string(objectID)).
+		Strs("files", files).
+		Msg("starting to set files")
+
+	err := m.client.DOM.SetFileInputFiles(ctx, dom.NewSetFileInputFilesArgs(files).SetObjectID(objectID))
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to set files")
+
+		return err
+	}
+
+	m.logger.Trace().Msg("set files")
+
+	return nil
+}
+
+func (m *Manager) SetFilesBySelector(ctx context.Context, id runtime.RemoteObjectID, selector drivers.QuerySelector, files []string) error {
+	m.logger.Trace().
+		Str("parent_object_id", string(id)).
+		Str("selector", selector.String()).
+		Msg("starting to set files by selector")
+
+	m.logger.Trace().Msg("looking up for an element by selector")
+
+	found, err := m.exec.EvalRef(ctx, templates.QuerySelector(id, selector))
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to find an element by selector")
+
+		return err
+	}
+
+	if found.ObjectID == nil {
+		m.logger.Trace().
+			Err(core.ErrNotFound).
+			Msg("element not found by selector")
+
+		return core.ErrNotFound
+	}
+
+	return m.SetFiles(ctx, *found.ObjectID, files)
+}
@@ -9,13 +9,15 @@ import (
 )
 
 type Mouse struct {
-	client *cdp.Client
-	x      float64
-	y      float64
+	client  *cdp.Client
+	profile Profile
+	x       float64
+	y       float64
+	button  input.MouseButton
 }
 
-func NewMouse(client *cdp.Client) *Mouse {
-	return &Mouse{client, 0, 0}
+func NewMouse(client *cdp.Client, profile Profile) *Mouse {
+	return &Mouse{client, profile, 0, 0, input.MouseButtonNone}
 }
 
 func (m *Mouse) Click(ctx context.Context, x, y float64, delay time.Duration) error {
@@ -23,17 +25,21 @@ func (m *Mouse) Click(ctx context.Context, x, y float64, delay time.Duration) er
 }
 
 func (m *Mouse) ClickWithCount(ctx context.Context, x, y float64, delay time.Duration, count int) error {
+	return m.ClickWithButton(ctx, x, y, delay, input.MouseButtonLeft, count)
+}
+
+func (m *Mouse) ClickWithButton(ctx context.Context, x, y float64, delay time.Duration, button input.MouseButton, count int) error {
 	if err := m.Move(ctx, x, y); err != nil {
 		return err
 	}
 
-	if err := m.DownWithCount(ctx, input.MouseButtonLeft, count); err != nil {
+	if err := m.DownWithCount(ctx, button, count); err != nil {
 		return err
 	}
 
-	time.Sleep(randomDuration(int(delay)))
+	time.Sleep(m.profile.PressDelay(delay))
 
-	return m.UpWithCount(ctx, input.MouseButtonLeft, count)
+	return m.UpWithCount(ctx, button, count)
 }
 
 func (m *Mouse) Down(ctx context.Context, button input.MouseButton) error {
@@ -41,12 +47,21 @@ func (m *Mouse) Down(ctx context.Context, button input.MouseButton) error {
 }
 
 func (m *Mouse) DownWithCount(ctx context.Context, button input.MouseButton, count int) error {
-	return m.client.Input.DispatchMouseEvent(
+	err := m.client.Input.DispatchMouseEvent(
 		ctx,
 		input.NewDispatchMouseEventArgs("mousePressed", m.x, m.y).
 			SetButton(button).
+			SetButtons(toButtons(button)).
 			SetClickCount(count),
 	)
+
+	if err != nil {
+		return err
+	}
+
+	m.button = button
+
+	return nil
 }
 
 func (m *Mouse) Up(ctx context.Context, button input.MouseButton) error {
@@ -54,6 +69,8 @@ func (m *Mouse) Up(ctx context.Context, button input.MouseButton) error {
 }
 
 func (m *Mouse) UpWithCount(ctx context.Context, button input.MouseButton, count int) error {
+	m.button = input.MouseButtonNone
+
 	return m.client.Input.DispatchMouseEvent(
 		ctx,
 		input.NewDispatchMouseEventArgs("mouseReleased", m.x, m.y).
@@ -67,18 +84,21 @@ func (m *Mouse) Move(ctx context.Context, x, y float64) error {
 }
 
 func (m *Mouse) MoveBySteps(ctx context.Context, x, y float64, steps int) error {
-	fromX := m.x
-	fromY := m.y
+	points := m.profile.Path(Quad{X: m.x, Y: m.y}, Quad{X: x, Y: y}, steps)
 
-	for i := 0; i <= steps; i++ {
-		iFloat := float64(i)
-		stepFloat := float64(steps)
-		toX := fromX + (x-fromX)*(iFloat/stepFloat)
-		toY := fromY + (y-fromY)*(iFloat/stepFloat)
+	for i, point := range points {
+		if i > 0 {
+			if delay := m.profile.MoveDelay(); delay > 0 {
+				time.Sleep(delay)
+			}
+		}
 
+		// pressed button is reported during moves, so that drag handlers can recognize it
 		err := m.client.Input.DispatchMouseEvent(
 			ctx,
-			input.NewDispatchMouseEventArgs("mouseMoved", toX, toY),
+			input.NewDispatchMouseEventArgs("mouseMoved", point.X, point.Y).
+				SetButton(m.button).
+				SetButtons(toButtons(m.button)),
 		)
 
 		if err != nil {
@@ -91,3 +111,30 @@ func (m *Mouse) MoveBySteps(ctx context.Context, x, y float64, steps int) error
 
 	return nil
 }
+
+func (m *Mouse) Wheel(ctx context.Context, deltaX, deltaY float64) error {
+	return m.client.Input.DispatchMouseEvent(
+		ctx,
+		input.NewDispatchMouseEventArgs("mouseWheel", m.x, m.y).
+			SetDeltaX(deltaX).
+			SetDeltaY(deltaY),
+	)
+}
+
+// toButtons converts a given button to a bit field of pressed buttons.
+func toButtons(button input.MouseButton) int {
+	switch button {
+	case input.MouseButtonLeft:
+		return 1
+	case input.MouseButtonRight:
+		return 2
+	case input.MouseButtonMiddle:
+		return 4
+	case input.MouseButtonBack:
+		return 8
+	case input.MouseButtonForward:
+		return 16
+	default:
+		return 0
+	}
+}
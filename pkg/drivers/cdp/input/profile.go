@@ -0,0 +1,206 @@
+package input
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+type (
+	// Profile defines how simulated user input looks like.
+	Profile interface {
+		// Path returns points the mouse passes moving from one point to another, including both of them.
+		Path(from, to Quad, steps int) []Quad
+
+		// MoveDelay returns a pause between two mouse moves.
+		MoveDelay() time.Duration
+
+		// PressDelay returns for how long a key or a mouse button is held, based on a given delay.
+		PressDelay(delay time.Duration) time.Duration
+
+		// TypeDelay returns a pause between two keystrokes, based on a given delay.
+		TypeDelay(delay time.Duration) time.Duration
+
+		// Typo returns a character to type by mistake instead of a given one.
+		Typo(ch rune) (rune, bool)
+	}
+
+	linearProfile struct{}
+
+	humanProfile struct {
+		mu   sync.Mutex
+		rand *rand.Rand
+	}
+)
+
+const (
+	humanTypoRate       = 0.03
+	humanHesitationRate = 0.05
+	humanMaxSteps       = 60
+)
+
+// qwertyNeighbors contains adjacent keys of a US keyboard, which are used to simulate typos.
+var qwertyNeighbors = map[rune]string{
+	'q': "wa", 'w': "qes", 'e': "wrd", 'r': "etf", 't': "ryg", 'y': "tuh", 'u': "yij", 'i': "uok", 'o': "ipl", 'p': "o",
+	'a': "qsz", 's': "awdz", 'd': "sefx", 'f': "drgc", 'g': "fthv", 'h': "gyjb", 'j': "hukn", 'k': "jilm", 'l': "ko",
+	'z': "asx", 'x': "zsdc", 'c': "xdfv", 'v': "cfgb", 'b': "vghn", 'n': "bhjm", 'm': "njk",
+}
+
+// NewProfile returns an input profile by a given name.
+func NewProfile(name drivers.InputProfile) (Profile, error) {
+	switch name {
+	case drivers.InputProfileDefault:
+		return &linearProfile{}, nil
+	case drivers.InputProfileHuman:
+		return &humanProfile{
+			rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+		}, nil
+	default:
+		return nil, core.Errorf(core.ErrInvalidArgument, "input profile: %s", name)
+	}
+}
+
+func (p *linearProfile) Path(from, to Quad, steps int) []Quad {
+	points := make([]Quad, 0, steps+1)
+
+	for i := 0; i <= steps; i++ {
+		progress := float64(i) / float64(steps)
+
+		points = append(points, Quad{
+			X: from.X + (to.X-from.X)*progress,
+			Y: from.Y + (to.Y-from.Y)*progress,
+		})
+	}
+
+	return points
+}
+
+func (p *linearProfile) MoveDelay() time.Duration {
+	return 0
+}
+
+func (p *linearProfile) PressDelay(delay time.Duration) time.Duration {
+	return randomDuration(int(delay))
+}
+
+func (p *linearProfile) TypeDelay(_ time.Duration) time.Duration {
+	return 0
+}
+
+func (p *linearProfile) Typo(_ rune) (rune, bool) {
+	return 0, false
+}
+
+// Path returns points of a cubic Bezier curve with randomly placed control points.
+// Intermediate points get a slight jitter.
+func (p *humanProfile) Path(from, to Quad, steps int) []Quad {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	distance := math.Hypot(to.X-from.X, to.Y-from.Y)
+
+	// the longer the distance, the more intermediate moves a human makes
+	steps = int(math.Max(float64(steps), math.Min(distance/15+5, humanMaxSteps)))
+
+	// control points are shifted aside the straight line
+	normalX, normalY := 0.0, 0.0
+
+	if distance > 0 {
+		normalX = -(to.Y - from.Y) / distance
+		normalY = (to.X - from.X) / distance
+	}
+
+	c1 := p.controlPoint(from, to, 0.25+p.rand.Float64()*0.2, normalX, normalY, distance)
+	c2 := p.controlPoint(from, to, 0.55+p.rand.Float64()*0.2, normalX, normalY, distance)
+
+	points := make([]Quad, 0, steps+1)
+
+	for i := 0; i <= steps; i++ {
+		// ease in and out, so that the mouse accelerates and slows down
+		t := float64(i) / float64(steps)
+		t = t * t * (3 - 2*t)
+		point := bezier(from, c1, c2, to, t)
+
+		if i > 0 && i < steps {
+			point.X += p.rand.NormFloat64() * 0.5
+			point.Y += p.rand.NormFloat64() * 0.5
+		}
+
+		points = append(points, point)
+	}
+
+	return points
+}
+
+func (p *humanProfile) MoveDelay() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return time.Duration(8+p.rand.Intn(12)) * time.Millisecond
+}
+
+func (p *humanProfile) PressDelay(delay time.Duration) time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.vary(delay/2, 0.3)
+}
+
+func (p *humanProfile) TypeDelay(delay time.Duration) time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// from time to time a human stops to think
+	if p.rand.Float64() < humanHesitationRate {
+		return p.vary(delay*4, 0.5)
+	}
+
+	return p.vary(delay, 0.4)
+}
+
+func (p *humanProfile) Typo(ch rune) (rune, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	neighbors, found := qwertyNeighbors[unicode.ToLower(ch)]
+
+	if !found || p.rand.Float64() >= humanTypoRate {
+		return 0, false
+	}
+
+	typo := rune(neighbors[p.rand.Intn(len(neighbors))])
+
+	if unicode.IsUpper(ch) {
+		typo = unicode.ToUpper(typo)
+	}
+
+	return typo, true
+}
+
+func (p *humanProfile) controlPoint(from, to Quad, position, normalX, normalY, distance float64) Quad {
+	offset := (p.rand.Float64() - 0.5) * distance * 0.4
+
+	return Quad{
+		X: from.X + (to.X-from.X)*position + normalX*offset,
+		Y: from.Y + (to.Y-from.Y)*position + normalY*offset,
+	}
+}
+
+// vary returns a log-normally distributed duration around a given one.
+func (p *humanProfile) vary(delay time.Duration, spread float64) time.Duration {
+	return time.Duration(float64(delay) * math.Exp(p.rand.NormFloat64()*spread))
+}
+
+func bezier(p0, p1, p2, p3 Quad, t float64) Quad {
+	u := 1 - t
+
+	return Quad{
+		X: u*u*u*p0.X + 3*u*u*t*p1.X + 3*u*t*t*p2.X + t*t*t*p3.X,
+		Y: u*u*u*p0.Y + 3*u*u*t*p1.Y + 3*u*t*t*p2.Y + t*t*t*p3.Y,
+	}
+}
@@ -0,0 +1,79 @@
+package input_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/input"
+)
+
+func TestProfile(t *testing.T) {
+	Convey("Input profile", t, func() {
+		from := input.Quad{X: 10, Y: 10}
+		to := input.Quad{X: 310, Y: 210}
+
+		Convey("Should fail on unknown profile", func() {
+			_, err := input.NewProfile("robot")
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Default", func() {
+			profile, err := input.NewProfile(drivers.InputProfileDefault)
+
+			So(err, ShouldBeNil)
+
+			Convey("Should move along a straight line", func() {
+				path := profile.Path(from, to, 2)
+
+				So(path, ShouldResemble, []input.Quad{from, {X: 160, Y: 110}, to})
+			})
+
+			Convey("Should not make typos", func() {
+				for _, ch := range "the quick brown fox jumps over the lazy dog" {
+					_, ok := profile.Typo(ch)
+
+					So(ok, ShouldBeFalse)
+				}
+			})
+		})
+
+		Convey("Human", func() {
+			profile, err := input.NewProfile(drivers.InputProfileHuman)
+
+			So(err, ShouldBeNil)
+
+			Convey("Should start and end at given points", func() {
+				path := profile.Path(from, to, 1)
+
+				So(len(path), ShouldBeGreaterThan, 2)
+				So(path[0], ShouldResemble, from)
+				So(path[len(path)-1].X, ShouldAlmostEqual, to.X)
+				So(path[len(path)-1].Y, ShouldAlmostEqual, to.Y)
+			})
+
+			Convey("Should vary typing delays", func() {
+				delays := make(map[time.Duration]bool)
+
+				for i := 0; i < 10; i++ {
+					delays[profile.TypeDelay(100*time.Millisecond)] = true
+				}
+
+				So(len(delays), ShouldBeGreaterThan, 1)
+			})
+
+			Convey("Should mistype with adjacent keys only", func() {
+				for i := 0; i < 1000; i++ {
+					typo, ok := profile.Typo('a')
+
+					if ok {
+						So(string(typo), ShouldBeIn, []string{"q", "s", "z"})
+					}
+				}
+			})
+		})
+	})
+}
@@ -12,10 +12,20 @@ import (
 	"github.com/MontFerret/ferret/pkg/drivers/cdp/utils"
 )
 
-type Quad struct {
-	X float64
-	Y float64
-}
+type (
+	Quad struct {
+		X float64
+		Y float64
+	}
+
+	// BoundingBox is a rectangle in page coordinates.
+	BoundingBox struct {
+		X      float64
+		Y      float64
+		Width  float64
+		Height float64
+	}
+)
 
 func fromProtocolQuad(quad dom.Quad) []Quad {
 	return []Quad{
@@ -114,3 +124,45 @@ func getClickablePoint(ctx context.Context, client *cdp.Client, qargs *dom.GetCo
 func GetClickablePointByObjectID(ctx context.Context, client *cdp.Client, objectID runtime.RemoteObjectID) (Quad, error) {
 	return getClickablePoint(ctx, client, dom.NewGetContentQuadsArgs().SetObjectID(objectID))
 }
+
+// GetBoundingBoxByObjectID returns a box enclosing all content quads of a given node.
+// Unlike clickable points, the box is not limited by the viewport.
+func GetBoundingBoxByObjectID(ctx context.Context, client *cdp.Client, objectID runtime.RemoteObjectID) (BoundingBox, error) {
+	contentQuadsReply, err := client.DOM.GetContentQuads(ctx, dom.NewGetContentQuadsArgs().SetObjectID(objectID))
+
+	if err != nil {
+		return BoundingBox{}, err
+	}
+
+	if len(contentQuadsReply.Quads) == 0 {
+		return BoundingBox{}, errors.New("node is either not visible or not an HTMLElement")
+	}
+
+	layoutMetricsReply, err := client.Page.GetLayoutMetrics(ctx)
+
+	if err != nil {
+		return BoundingBox{}, err
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+
+	for _, q := range contentQuadsReply.Quads {
+		for _, point := range fromProtocolQuad(q) {
+			minX = math.Min(minX, point.X)
+			minY = math.Min(minY, point.Y)
+			maxX = math.Max(maxX, point.X)
+			maxY = math.Max(maxY, point.Y)
+		}
+	}
+
+	// content quads are relative to the viewport
+	pageX, pageY := utils.GetVisualViewportPageXY(layoutMetricsReply)
+
+	return BoundingBox{
+		X:      minX + pageX,
+		Y:      minY + pageY,
+		Width:  maxX - minX,
+		Height: maxY - minY,
+	}, nil
+}
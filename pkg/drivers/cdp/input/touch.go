@@ -0,0 +1,79 @@
+package input
+
+import (
+	"context"
+	"math"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/input"
+)
+
+// pinchDistance is an initial distance in pixels between two fingers of a pinch gesture.
+const pinchDistance = 100.0
+
+type Touch struct {
+	client *cdp.Client
+}
+
+func NewTouch(client *cdp.Client) *Touch {
+	return &Touch{client}
+}
+
+func (t *Touch) Tap(ctx context.Context, x, y float64) error {
+	if err := t.dispatch(ctx, "touchStart", []input.TouchPoint{{X: x, Y: y}}); err != nil {
+		return err
+	}
+
+	return t.dispatch(ctx, "touchEnd", []input.TouchPoint{})
+}
+
+func (t *Touch) Swipe(ctx context.Context, x, y, deltaX, deltaY float64, steps int) error {
+	if err := t.dispatch(ctx, "touchStart", []input.TouchPoint{{X: x, Y: y}}); err != nil {
+		return err
+	}
+
+	for i := 1; i <= steps; i++ {
+		progress := float64(i) / float64(steps)
+
+		err := t.dispatch(ctx, "touchMove", []input.TouchPoint{
+			{X: x + deltaX*progress, Y: y + deltaY*progress},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return t.dispatch(ctx, "touchEnd", []input.TouchPoint{})
+}
+
+// Pinch moves two fingers placed around a given point apart (scale > 1) or together (scale < 1).
+func (t *Touch) Pinch(ctx context.Context, x, y, scale float64, steps int) error {
+	from := pinchDistance / 2
+	to := math.Max(from*scale, 1)
+
+	points := func(offset float64) []input.TouchPoint {
+		return []input.TouchPoint{
+			{X: x - offset, Y: y},
+			{X: x + offset, Y: y},
+		}
+	}
+
+	if err := t.dispatch(ctx, "touchStart", points(from)); err != nil {
+		return err
+	}
+
+	for i := 1; i <= steps; i++ {
+		progress := float64(i) / float64(steps)
+
+		if err := t.dispatch(ctx, "touchMove", points(from+(to-from)*progress)); err != nil {
+			return err
+		}
+	}
+
+	return t.dispatch(ctx, "touchEnd", []input.TouchPoint{})
+}
+
+func (t *Touch) dispatch(ctx context.Context, typ string, points []input.TouchPoint) error {
+	return t.client.Input.DispatchTouchEvent(ctx, input.NewDispatchTouchEventArgs(typ, points))
+}
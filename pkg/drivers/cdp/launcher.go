@@ -0,0 +1,365 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp/devtool"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+)
+
+const (
+	DefaultLaunchTimeout = 30 * time.Second
+	DefaultMaxRestarts   = 3
+)
+
+type (
+	// LauncherOptions defines how a local browser gets launched.
+	LauncherOptions struct {
+		// Path is a path to a browser executable.
+		// If not set, the CHROME_PATH environment variable and well-known locations are searched.
+		Path string
+		// Args are additional command line arguments passed to a browser.
+		Args []string
+		// Headful indicates whether to launch a browser with a visible window.
+		Headful bool
+		// Timeout is a time to wait for the DevTools endpoint to become available.
+		Timeout time.Duration
+		// MaxRestarts is a number of automatic restarts after crashes.
+		// Zero means DefaultMaxRestarts, a negative value disables restarts.
+		MaxRestarts int
+	}
+
+	// Launcher runs a local headless Chrome or Chromium with a temporary profile on a free port.
+	// A crashed browser gets restarted on the same port, so that its address remains valid.
+	Launcher struct {
+		mu       sync.Mutex
+		logger   zerolog.Logger
+		options  LauncherOptions
+		cmd      *exec.Cmd
+		exited   chan struct{}
+		profile  string
+		port     int
+		restarts int
+		closed   bool
+		onCrash  func()
+	}
+)
+
+var (
+	browserNames = []string{
+		"google-chrome",
+		"google-chrome-stable",
+		"chromium",
+		"chromium-browser",
+		"chrome",
+		"headless-shell",
+		"headless_shell",
+	}
+
+	browserPaths = map[string][]string{
+		"darwin": {
+			"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+			"/Applications/Chromium.app/Contents/MacOS/Chromium",
+		},
+		"windows": {
+			filepath.Join(os.Getenv("ProgramFiles"), `Google\Chrome\Application\chrome.exe`),
+			filepath.Join(os.Getenv("ProgramFiles(x86)"), `Google\Chrome\Application\chrome.exe`),
+			filepath.Join(os.Getenv("LocalAppData"), `Google\Chrome\Application\chrome.exe`),
+			filepath.Join(os.Getenv("LocalAppData"), `Chromium\Application\chrome.exe`),
+		},
+	}
+)
+
+func NewLauncher(options LauncherOptions) *Launcher {
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultLaunchTimeout
+	}
+
+	if options.MaxRestarts == 0 {
+		options.MaxRestarts = DefaultMaxRestarts
+	}
+
+	l := new(Launcher)
+	l.logger = zerolog.Nop()
+	l.options = options
+
+	return l
+}
+
+// Launch starts a browser, unless it is already running, and returns an address of its DevTools endpoint.
+func (l *Launcher) Launch(ctx context.Context) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.logger = logging.WithName(logging.FromContext(ctx).With(), "cdp_launcher").Logger()
+
+	return l.launch(ctx)
+}
+
+// Close kills a running browser and removes its temporary profile.
+func (l *Launcher) Close() error {
+	l.mu.Lock()
+
+	if l.closed {
+		l.mu.Unlock()
+
+		return nil
+	}
+
+	l.closed = true
+	cmd := l.cmd
+	exited := l.exited
+	l.cmd = nil
+	l.mu.Unlock()
+
+	if cmd != nil {
+		l.logger.Trace().Int("pid", cmd.Process.Pid).Msg("killing browser")
+
+		if err := cmd.Process.Kill(); err != nil {
+			l.logger.Warn().Err(err).Msg("failed to kill browser")
+		}
+
+		<-exited
+	}
+
+	if l.profile != "" {
+		if err := os.RemoveAll(l.profile); err != nil {
+			return errors.Wrap(err, "remove browser profile")
+		}
+	}
+
+	return nil
+}
+
+func (l *Launcher) launch(ctx context.Context) (string, error) {
+	if l.closed {
+		return "", core.Error(core.ErrInvalidOperation, "launcher is closed")
+	}
+
+	if l.cmd != nil {
+		return l.address(), nil
+	}
+
+	if err := l.start(ctx); err != nil {
+		return "", err
+	}
+
+	return l.address(), nil
+}
+
+func (l *Launcher) address() string {
+	return fmt.Sprintf("http://127.0.0.1:%d", l.port)
+}
+
+func (l *Launcher) start(ctx context.Context) error {
+	path, err := l.lookPath()
+
+	if err != nil {
+		return err
+	}
+
+	if l.profile == "" {
+		profile, err := os.MkdirTemp("", "ferret-chrome-")
+
+		if err != nil {
+			return errors.Wrap(err, "create browser profile")
+		}
+
+		l.profile = profile
+	}
+
+	if l.port == 0 {
+		port, err := getFreePort()
+
+		if err != nil {
+			return errors.Wrap(err, "find free port")
+		}
+
+		l.port = port
+	}
+
+	cmd := exec.Command(path, launchArgs(l.options, l.profile, l.port)...)
+
+	l.logger.Trace().
+		Str("path", path).
+		Int("port", l.port).
+		Msg("starting browser")
+
+	if err := cmd.Start(); err != nil {
+		return errors.Wrap(err, "start browser")
+	}
+
+	exited := make(chan struct{})
+	l.cmd = cmd
+	l.exited = exited
+
+	go l.wait(cmd, exited)
+
+	if err := waitForEndpoint(ctx, l.address(), exited, l.options.Timeout); err != nil {
+		l.cmd = nil
+
+		select {
+		case <-exited:
+		default:
+			if err := cmd.Process.Kill(); err != nil {
+				l.logger.Warn().Err(err).Msg("failed to kill browser")
+			}
+
+			<-exited
+		}
+
+		return err
+	}
+
+	l.logger.Trace().
+		Int("pid", cmd.Process.Pid).
+		Msg("succeeded to start browser")
+
+	return nil
+}
+
+// wait waits for a browser process to exit and restarts it, if it has not been stopped by the launcher.
+func (l *Launcher) wait(cmd *exec.Cmd, exited chan struct{}) {
+	err := cmd.Wait()
+	close(exited)
+
+	l.mu.Lock()
+
+	if l.closed || l.cmd != cmd {
+		l.mu.Unlock()
+
+		return
+	}
+
+	l.cmd = nil
+	restart := l.restarts < l.options.MaxRestarts
+
+	if restart {
+		l.restarts++
+	}
+
+	logger := l.logger
+	onCrash := l.onCrash
+	l.mu.Unlock()
+
+	logger.Warn().
+		Err(err).
+		Bool("restart", restart).
+		Msg("browser exited unexpectedly")
+
+	if onCrash != nil {
+		onCrash()
+	}
+
+	if !restart {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.launch(context.Background()); err != nil {
+		logger.Error().Err(err).Msg("failed to restart browser")
+	}
+}
+
+func (l *Launcher) lookPath() (string, error) {
+	if l.options.Path != "" {
+		return l.options.Path, nil
+	}
+
+	if path := os.Getenv("CHROME_PATH"); path != "" {
+		return path, nil
+	}
+
+	for _, name := range browserNames {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+
+	for _, path := range browserPaths[runtime.GOOS] {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", core.Error(core.ErrNotFound, "chrome or chromium executable")
+}
+
+func launchArgs(options LauncherOptions, profile string, port int) []string {
+	args := []string{
+		"--remote-debugging-port=" + strconv.Itoa(port),
+		"--user-data-dir=" + profile,
+		"--no-first-run",
+		"--no-default-browser-check",
+		"--disable-background-networking",
+		"--disable-background-timer-throttling",
+		"--disable-backgrounding-occluded-windows",
+		"--disable-renderer-backgrounding",
+		"--disable-breakpad",
+		"--disable-extensions",
+		"--disable-sync",
+		"--metrics-recording-only",
+		"--mute-audio",
+	}
+
+	if !options.Headful {
+		args = append(args, "--headless", "--hide-scrollbars")
+	}
+
+	// Chrome refuses to run as root with the sandbox enabled
+	if os.Geteuid() == 0 {
+		args = append(args, "--no-sandbox")
+	}
+
+	args = append(args, options.Args...)
+
+	return append(args, BlankPageURL)
+}
+
+func getFreePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+func waitForEndpoint(ctx context.Context, address string, exited <-chan struct{}, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dev := devtool.New(address)
+
+	for {
+		if _, err := dev.Version(ctx); err == nil {
+			return nil
+		}
+
+		select {
+		case <-exited:
+			return errors.New("browser exited before the DevTools endpoint became available")
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "wait for the DevTools endpoint")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
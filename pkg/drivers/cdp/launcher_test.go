@@ -0,0 +1,145 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+)
+
+// fakeBrowserEnv makes the test binary act as a browser, which serves only the DevTools version endpoint.
+const fakeBrowserEnv = "FERRET_FAKE_BROWSER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(fakeBrowserEnv) == "1" {
+		runFakeBrowser()
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func runFakeBrowser() {
+	var port string
+
+	for _, arg := range os.Args {
+		if strings.HasPrefix(arg, "--remote-debugging-port=") {
+			port = strings.TrimPrefix(arg, "--remote-debugging-port=")
+		}
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:"+port)
+
+	if err != nil {
+		os.Exit(1)
+	}
+
+	http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"Browser":              "Fake/1.0",
+			"webSocketDebuggerUrl": "ws://127.0.0.1:" + port + "/devtools/browser/fake",
+		})
+	}))
+}
+
+func TestLauncher(t *testing.T) {
+	ctx := logging.WithContext(context.Background(), logging.Options{
+		Writer: io.Discard,
+		Level:  logging.ErrorLevel,
+	})
+
+	Convey(".launchArgs", t, func() {
+		Convey("Should launch a headless browser by default", func() {
+			args := launchArgs(LauncherOptions{Args: []string{"--lang=en"}}, "/tmp/profile", 9333)
+
+			So(args, ShouldContain, "--remote-debugging-port=9333")
+			So(args, ShouldContain, "--user-data-dir=/tmp/profile")
+			So(args, ShouldContain, "--headless")
+			So(args, ShouldContain, "--lang=en")
+			So(args[len(args)-1], ShouldEqual, BlankPageURL)
+		})
+
+		Convey("Should launch a headful browser", func() {
+			args := launchArgs(LauncherOptions{Headful: true}, "/tmp/profile", 9333)
+
+			So(args, ShouldNotContain, "--headless")
+		})
+	})
+
+	Convey(".Launch", t, func() {
+		Convey("Should fail when a browser cannot be started", func() {
+			l := NewLauncher(LauncherOptions{Path: "/not/existing/chrome"})
+			defer l.Close()
+
+			_, err := l.Launch(ctx)
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Should start, restart and kill a browser", func() {
+			t.Setenv(fakeBrowserEnv, "1")
+
+			l := NewLauncher(LauncherOptions{Path: os.Args[0], Timeout: 10 * time.Second})
+			crashes := make(chan struct{}, 1)
+			l.onCrash = func() {
+				crashes <- struct{}{}
+			}
+
+			address, err := l.Launch(ctx)
+
+			So(err, ShouldBeNil)
+			So(address, ShouldStartWith, "http://127.0.0.1:")
+
+			same, err := l.Launch(ctx)
+
+			So(err, ShouldBeNil)
+			So(same, ShouldEqual, address)
+
+			l.mu.Lock()
+			first := l.cmd
+			profile := l.profile
+			l.mu.Unlock()
+
+			So(first.Process.Kill(), ShouldBeNil)
+
+			select {
+			case <-crashes:
+			case <-time.After(10 * time.Second):
+				t.Fatal("crash is not reported")
+			}
+
+			restarted, err := l.Launch(ctx)
+
+			So(err, ShouldBeNil)
+			So(restarted, ShouldEqual, address)
+
+			l.mu.Lock()
+			second := l.cmd
+			l.mu.Unlock()
+
+			So(second, ShouldNotBeNil)
+			So(second.Process.Pid, ShouldNotEqual, first.Process.Pid)
+
+			So(l.Close(), ShouldBeNil)
+			So(second.ProcessState, ShouldNotBeNil)
+
+			_, err = os.Stat(profile)
+
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			_, err = l.Launch(ctx)
+
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
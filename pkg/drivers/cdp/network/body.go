@@ -0,0 +1,164 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+// loadingTrackerSize is a number of completed requests remembered by a loading tracker.
+const loadingTrackerSize = 1000
+
+type (
+	loadingState struct {
+		completed bool
+		errorText string
+	}
+
+	// loadingTracker keeps states of recently completed requests of a page,
+	// so that body loaders do not wait for completion events that have already fired.
+	loadingTracker struct {
+		mu      sync.Mutex
+		states  map[network.RequestID]loadingState
+		order   []network.RequestID
+		waiters map[network.RequestID]chan struct{}
+		stopped bool
+	}
+)
+
+func newLoadingTracker() *loadingTracker {
+	t := new(loadingTracker)
+	t.states = make(map[network.RequestID]loadingState)
+	t.order = make([]network.RequestID, 0, loadingTrackerSize)
+	t.waiters = make(map[network.RequestID]chan struct{})
+
+	return t
+}
+
+// Complete marks a given request as completed, a non-empty error text means the loading has failed.
+func (t *loadingTracker) Complete(id network.RequestID, errorText string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, exists := t.states[id]; !exists {
+		if len(t.order) == loadingTrackerSize {
+			delete(t.states, t.order[0])
+			t.order = t.order[1:]
+		}
+
+		t.order = append(t.order, id)
+	}
+
+	t.states[id] = loadingState{completed: true, errorText: errorText}
+
+	if waiter, exists := t.waiters[id]; exists {
+		close(waiter)
+		delete(t.waiters, id)
+	}
+}
+
+// State returns a state of a given request along with a channel
+// that gets closed once the request completes or the tracker stops.
+func (t *loadingTracker) State(id network.RequestID) (loadingState, <-chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := t.states[id]
+
+	if state.completed || t.stopped {
+		return state, nil
+	}
+
+	waiter, exists := t.waiters[id]
+
+	if !exists {
+		waiter = make(chan struct{})
+		t.waiters[id] = waiter
+	}
+
+	return state, waiter
+}
+
+// Stop releases all waiting loaders.
+func (t *loadingTracker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stopped = true
+
+	for id, waiter := range t.waiters {
+		close(waiter)
+		delete(t.waiters, id)
+	}
+}
+
+// newResponseBodyLoader returns a function that loads a body of a given response.
+// Since a response is received before its body, the function waits until the request gets completed,
+// unless it is already, but no longer than drivers.DefaultTimeout.
+func newResponseBodyLoader(logger zerolog.Logger, client *cdp.Client, tracker *loadingTracker, id network.RequestID) drivers.BodyLoader {
+	return func(ctx context.Context) ([]byte, error) {
+		log := logger.With().Str("request_id", string(id)).Logger()
+
+		body, firstErr := getResponseBody(ctx, client, id)
+
+		if firstErr == nil {
+			return body, nil
+		}
+
+		state, wait := tracker.State(id)
+
+		if wait != nil {
+			log.Trace().Err(firstErr).Msg("response body is not available yet, waiting for loading completion")
+
+			ctx, cancel := context.WithTimeout(ctx, time.Duration(drivers.DefaultTimeout)*time.Millisecond)
+			defer cancel()
+
+			select {
+			case <-ctx.Done():
+				return nil, errors.Wrapf(firstErr, "wait for response body: %s", ctx.Err())
+			case <-wait:
+			}
+
+			state, _ = tracker.State(id)
+		}
+
+		if !state.completed {
+			return nil, errors.Wrap(firstErr, "page is closed")
+		}
+
+		if state.errorText != "" {
+			return nil, errors.Errorf("failed to load response body: %s", state.errorText)
+		}
+
+		// the loading might have completed right after the first attempt
+		return getResponseBody(ctx, client, id)
+	}
+}
+
+func getResponseBody(ctx context.Context, client *cdp.Client, id network.RequestID) ([]byte, error) {
+	resp, err := client.Network.GetResponseBody(ctx, network.NewGetResponseBodyArgs(id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Base64Encoded {
+		body, err := base64.StdEncoding.DecodeString(resp.Body)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "decode response body")
+		}
+
+		return body, nil
+	}
+
+	return []byte(resp.Body), nil
+}
@@ -0,0 +1,74 @@
+package network
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mafredri/cdp/protocol/network"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLoadingTracker(t *testing.T) {
+	Convey("Should return a state of a completed request without waiting", t, func() {
+		tracker := newLoadingTracker()
+		tracker.Complete("1", "")
+		tracker.Complete("2", "net::ERR_FAILED")
+
+		state, wait := tracker.State("1")
+
+		So(wait, ShouldBeNil)
+		So(state.completed, ShouldBeTrue)
+		So(state.errorText, ShouldBeEmpty)
+
+		state, wait = tracker.State("2")
+
+		So(wait, ShouldBeNil)
+		So(state.errorText, ShouldEqual, "net::ERR_FAILED")
+	})
+
+	Convey("Should release waiters once a request completes", t, func() {
+		tracker := newLoadingTracker()
+
+		state, wait := tracker.State("1")
+
+		So(state.completed, ShouldBeFalse)
+		So(wait, ShouldNotBeNil)
+
+		tracker.Complete("1", "")
+
+		<-wait
+
+		state, _ = tracker.State("1")
+
+		So(state.completed, ShouldBeTrue)
+	})
+
+	Convey("Should release waiters once stopped", t, func() {
+		tracker := newLoadingTracker()
+
+		_, wait := tracker.State("1")
+
+		tracker.Stop()
+
+		<-wait
+
+		state, wait := tracker.State("1")
+
+		So(state.completed, ShouldBeFalse)
+		So(wait, ShouldBeNil)
+	})
+
+	Convey("Should forget the oldest requests", t, func() {
+		tracker := newLoadingTracker()
+
+		for i := 0; i <= loadingTrackerSize; i++ {
+			tracker.Complete(network.RequestID(strconv.Itoa(i)), "")
+		}
+
+		So(tracker.states, ShouldHaveLength, loadingTrackerSize)
+
+		state, _ := tracker.State("0")
+
+		So(state.completed, ShouldBeFalse)
+	})
+}
@@ -13,6 +13,8 @@ import (
 
 var (
 	responseReceivedEvent = events.New("response_received")
+	loadingFinishedEvent  = events.New("loading_finished")
+	loadingFailedEvent    = events.New("loading_failed")
 	requestPausedEvent    = events.New("request_paused")
 )
 
@@ -24,6 +26,22 @@ func createResponseReceivedStreamFactory(client *cdp.Client) events.SourceFactor
 	})
 }
 
+func createLoadingFinishedStreamFactory(client *cdp.Client) events.SourceFactory {
+	return events.NewStreamSourceFactory(loadingFinishedEvent, func(ctx context.Context) (rpcc.Stream, error) {
+		return client.Network.LoadingFinished(ctx)
+	}, func(stream rpcc.Stream) (interface{}, error) {
+		return stream.(network.LoadingFinishedClient).Recv()
+	})
+}
+
+func createLoadingFailedStreamFactory(client *cdp.Client) events.SourceFactory {
+	return events.NewStreamSourceFactory(loadingFailedEvent, func(ctx context.Context) (rpcc.Stream, error) {
+		return client.Network.LoadingFailed(ctx)
+	}, func(stream rpcc.Stream) (interface{}, error) {
+		return stream.(network.LoadingFailedClient).Recv()
+	})
+}
+
 func createRequestPausedStreamFactory(client *cdp.Client) events.SourceFactory {
 	return events.NewStreamSourceFactory(requestPausedEvent, func(ctx context.Context) (rpcc.Stream, error) {
 		return client.Fetch.RequestPaused(ctx)
@@ -0,0 +1,627 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+const (
+	harVersion = "1.2"
+	// harBodyWorkers is a number of response bodies fetched concurrently.
+	harBodyWorkers = 4
+	// harBodyQueueSize is a number of response bodies waiting to be fetched,
+	// bodies of responses finished while the queue is full are not recorded.
+	harBodyQueueSize = 100
+	ferretModule     = "github.com/MontFerret/ferret"
+)
+
+var harCreatorVersion = moduleVersion()
+
+type (
+	HAR struct {
+		Log HARLog `json:"log"`
+	}
+
+	HARLog struct {
+		Version string     `json:"version"`
+		Creator HARCreator `json:"creator"`
+		Entries []HAREntry `json:"entries"`
+	}
+
+	HARCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	HAREntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         HARRequest  `json:"request"`
+		Response        HARResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         HARTimings  `json:"timings"`
+		ServerIPAddress string      `json:"serverIPAddress,omitempty"`
+		Comment         string      `json:"comment,omitempty"`
+	}
+
+	HARNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	HARRequest struct {
+		Method      string         `json:"method"`
+		URL         string         `json:"url"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []HARNameValue `json:"cookies"`
+		Headers     []HARNameValue `json:"headers"`
+		QueryString []HARNameValue `json:"queryString"`
+		PostData    *HARPostData   `json:"postData,omitempty"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	HARPostData struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+
+	HARResponse struct {
+		Status      int            `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []HARNameValue `json:"cookies"`
+		Headers     []HARNameValue `json:"headers"`
+		Content     HARContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	HARContent struct {
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text,omitempty"`
+		Encoding string `json:"encoding,omitempty"`
+	}
+
+	// HARTimings contains durations in milliseconds, -1 means the phase does not apply.
+	HARTimings struct {
+		Blocked float64 `json:"blocked"`
+		DNS     float64 `json:"dns"`
+		Connect float64 `json:"connect"`
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+		SSL     float64 `json:"ssl"`
+	}
+
+	harRecord struct {
+		entry     HAREntry
+		timestamp network.MonotonicTime
+		timing    *network.ResourceTiming
+	}
+
+	harBody struct {
+		id  network.RequestID
+		rec *harRecord
+	}
+
+	// harRecorder collects network traffic of a page in HAR format.
+	// Response bodies are fetched by background workers, so that the event loop does not wait for them.
+	harRecorder struct {
+		mu       sync.Mutex
+		logger   zerolog.Logger
+		client   *cdp.Client
+		content  bool
+		pending  map[network.RequestID]*harRecord
+		finished []*harRecord
+		bodies   chan harBody
+		loading  int
+		idle     chan struct{}
+		stopped  <-chan struct{}
+	}
+)
+
+func newHARRecorder(logger zerolog.Logger, client *cdp.Client, params drivers.HARParams) *harRecorder {
+	r := new(harRecorder)
+	r.logger = logger
+	r.client = client
+	r.content = params.Content
+	r.pending = make(map[network.RequestID]*harRecord)
+	r.finished = make([]*harRecord, 0, 50)
+
+	if r.content {
+		r.bodies = make(chan harBody, harBodyQueueSize)
+	}
+
+	return r
+}
+
+// Run starts recording until a given context is done.
+// Network events are read from synchronized streams, so they get handled in the order they arrived in.
+func (r *harRecorder) Run(ctx context.Context) error {
+	onRequest, err := r.client.Network.RequestWillBeSent(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	onResponse, err := r.client.Network.ResponseReceived(ctx)
+
+	if err != nil {
+		onRequest.Close()
+
+		return err
+	}
+
+	onFinished, err := r.client.Network.LoadingFinished(ctx)
+
+	if err != nil {
+		onRequest.Close()
+		onResponse.Close()
+
+		return err
+	}
+
+	onFailed, err := r.client.Network.LoadingFailed(ctx)
+
+	if err != nil {
+		onRequest.Close()
+		onResponse.Close()
+		onFinished.Close()
+
+		return err
+	}
+
+	streams := []rpcc.Stream{onRequest, onResponse, onFinished, onFailed}
+
+	if err := rpcc.Sync(streams...); err != nil {
+		for _, stream := range streams {
+			stream.Close()
+		}
+
+		return err
+	}
+
+	r.stopped = ctx.Done()
+
+	if r.content {
+		for i := 0; i < harBodyWorkers; i++ {
+			go r.loadBodies(ctx)
+		}
+	}
+
+	go func() {
+		defer func() {
+			for _, stream := range streams {
+				stream.Close()
+			}
+		}()
+
+		for {
+			var err error
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-onRequest.Ready():
+				var repl *network.RequestWillBeSentReply
+
+				if repl, err = onRequest.Recv(); err == nil {
+					r.handleRequest(repl)
+				}
+			case <-onResponse.Ready():
+				var repl *network.ResponseReceivedReply
+
+				if repl, err = onResponse.Recv(); err == nil {
+					r.handleResponse(repl)
+				}
+			case <-onFinished.Ready():
+				var repl *network.LoadingFinishedReply
+
+				if repl, err = onFinished.Recv(); err == nil {
+					r.handleFinished(repl)
+				}
+			case <-onFailed.Ready():
+				var repl *network.LoadingFailedReply
+
+				if repl, err = onFailed.Recv(); err == nil {
+					r.handleFailed(repl)
+				}
+			}
+
+			if err != nil {
+				r.logger.Trace().Err(err).Msg("failed to read network event for HAR")
+
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// HAR returns recorded traffic. Requests that are still in progress are included as well.
+// Response bodies that are being fetched are waited for until a given context is done.
+func (r *harRecorder) HAR(ctx context.Context) HAR {
+	r.waitForBodies(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records := make([]*harRecord, 0, len(r.finished)+len(r.pending))
+	records = append(records, r.finished...)
+
+	for _, rec := range r.pending {
+		records = append(records, rec)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].timestamp < records[j].timestamp
+	})
+
+	entries := make([]HAREntry, 0, len(records))
+
+	for _, rec := range records {
+		entries = append(entries, rec.entry)
+	}
+
+	return HAR{
+		Log: HARLog{
+			Version: harVersion,
+			Creator: HARCreator{
+				Name:    "ferret",
+				Version: harCreatorVersion,
+			},
+			Entries: entries,
+		},
+	}
+}
+
+func (r *harRecorder) handleRequest(msg *network.RequestWillBeSentReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// redirects reuse the same request id
+	if prev, exists := r.pending[msg.RequestID]; exists && msg.RedirectResponse != nil {
+		setHARResponse(prev, *msg.RedirectResponse)
+		prev.entry.Response.RedirectURL = msg.Request.URL
+		r.finish(msg.RequestID, prev, msg.Timestamp)
+	}
+
+	req := HARRequest{
+		Method:      msg.Request.Method,
+		URL:         msg.Request.URL,
+		HTTPVersion: "",
+		Cookies:     make([]HARNameValue, 0),
+		Headers:     toHARHeaders(msg.Request.Headers),
+		QueryString: toHARQueryString(msg.Request.URL),
+		HeadersSize: -1,
+		BodySize:    0,
+	}
+
+	if msg.Request.PostData != nil {
+		req.BodySize = len(*msg.Request.PostData)
+		req.PostData = &HARPostData{
+			MimeType: findHARHeader(req.Headers, "Content-Type"),
+			Text:     *msg.Request.PostData,
+		}
+	}
+
+	r.pending[msg.RequestID] = &harRecord{
+		timestamp: msg.Timestamp,
+		entry: HAREntry{
+			StartedDateTime: msg.WallTime.Time().UTC().Format(time.RFC3339Nano),
+			Request:         req,
+			Response: HARResponse{
+				Cookies:     make([]HARNameValue, 0),
+				Headers:     make([]HARNameValue, 0),
+				HeadersSize: -1,
+			},
+			Timings: HARTimings{
+				Blocked: -1,
+				DNS:     -1,
+				Connect: -1,
+				SSL:     -1,
+			},
+		},
+	}
+}
+
+func (r *harRecorder) handleResponse(msg *network.ResponseReceivedReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, exists := r.pending[msg.RequestID]
+
+	if !exists {
+		return
+	}
+
+	setHARResponse(rec, msg.Response)
+}
+
+func (r *harRecorder) handleFinished(msg *network.LoadingFinishedReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, exists := r.pending[msg.RequestID]
+
+	if !exists {
+		return
+	}
+
+	rec.entry.Response.BodySize = int(msg.EncodedDataLength)
+	rec.entry.Response.Content.Size = int(msg.EncodedDataLength)
+
+	r.finish(msg.RequestID, rec, msg.Timestamp)
+
+	if !r.content {
+		return
+	}
+
+	select {
+	case r.bodies <- harBody{id: msg.RequestID, rec: rec}:
+		r.loading++
+	default:
+		r.logger.Trace().
+			Str("request_id", string(msg.RequestID)).
+			Msg("response body queue is full, skipping response body for HAR")
+	}
+}
+
+func (r *harRecorder) handleFailed(msg *network.LoadingFailedReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, exists := r.pending[msg.RequestID]
+
+	if !exists {
+		return
+	}
+
+	rec.entry.Comment = msg.ErrorText
+
+	r.finish(msg.RequestID, rec, msg.Timestamp)
+}
+
+func (r *harRecorder) loadBodies(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case body := <-r.bodies:
+			r.loadBody(ctx, body)
+		}
+	}
+}
+
+func (r *harRecorder) loadBody(ctx context.Context, body harBody) {
+	repl, err := r.client.Network.GetResponseBody(ctx, network.NewGetResponseBodyArgs(body.id))
+
+	if err != nil {
+		r.logger.Trace().
+			Err(err).
+			Str("request_id", string(body.id)).
+			Msg("failed to get response body for HAR")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err == nil {
+		body.rec.entry.Response.Content.Text = repl.Body
+
+		if repl.Base64Encoded {
+			body.rec.entry.Response.Content.Encoding = "base64"
+		}
+	}
+
+	r.loading--
+
+	if r.loading == 0 && r.idle != nil {
+		close(r.idle)
+		r.idle = nil
+	}
+}
+
+// waitForBodies waits until all queued response bodies are fetched, the recording is stopped or a given context is done.
+func (r *harRecorder) waitForBodies(ctx context.Context) {
+	r.mu.Lock()
+
+	if r.loading == 0 {
+		r.mu.Unlock()
+
+		return
+	}
+
+	if r.idle == nil {
+		r.idle = make(chan struct{})
+	}
+
+	idle := r.idle
+	r.mu.Unlock()
+
+	select {
+	case <-idle:
+	case <-r.stopped:
+	case <-ctx.Done():
+	}
+}
+
+func (r *harRecorder) finish(id network.RequestID, rec *harRecord, end network.MonotonicTime) {
+	delete(r.pending, id)
+
+	setHARTimings(rec, end)
+
+	r.finished = append(r.finished, rec)
+}
+
+func setHARResponse(rec *harRecord, resp network.Response) {
+	rec.timing = resp.Timing
+
+	var protocol string
+
+	if resp.Protocol != nil {
+		protocol = *resp.Protocol
+	}
+
+	rec.entry.Request.HTTPVersion = protocol
+
+	if resp.RequestHeaders != nil {
+		rec.entry.Request.Headers = toHARHeaders(resp.RequestHeaders)
+	}
+
+	rec.entry.Response.Status = resp.Status
+	rec.entry.Response.StatusText = resp.StatusText
+	rec.entry.Response.HTTPVersion = protocol
+	rec.entry.Response.Headers = toHARHeaders(resp.Headers)
+	rec.entry.Response.Content.MimeType = resp.MimeType
+	rec.entry.Response.RedirectURL = findHARHeader(rec.entry.Response.Headers, "Location")
+
+	if resp.RemoteIPAddress != nil {
+		rec.entry.ServerIPAddress = *resp.RemoteIPAddress
+	}
+}
+
+func setHARTimings(rec *harRecord, end network.MonotonicTime) {
+	timings := &rec.entry.Timings
+	t := rec.timing
+
+	if t == nil {
+		timings.Send = 0
+		timings.Wait = 0
+		timings.Receive = toHARDuration(float64(end-rec.timestamp) * 1000)
+		rec.entry.Time = timings.Receive
+
+		return
+	}
+
+	timings.Blocked = toHARDuration((t.RequestTime - float64(rec.timestamp)) * 1000)
+
+	// time spent before a connection is established
+	for _, start := range []float64{t.DNSStart, t.ConnectStart, t.SendStart} {
+		if start >= 0 {
+			timings.Blocked = toHARDuration(timings.Blocked + start)
+
+			break
+		}
+	}
+
+	timings.DNS = toHARPhase(t.DNSStart, t.DNSEnd)
+	timings.Connect = toHARPhase(t.ConnectStart, t.ConnectEnd)
+	timings.SSL = toHARPhase(t.SSLStart, t.SSLEnd)
+	timings.Send = toHARDuration(t.SendEnd - t.SendStart)
+	timings.Wait = toHARDuration(t.ReceiveHeadersEnd - t.SendEnd)
+	timings.Receive = toHARDuration((float64(end)-t.RequestTime)*1000 - t.ReceiveHeadersEnd)
+
+	var total float64
+
+	// ssl time is a part of the connect one
+	for _, phase := range []float64{timings.Blocked, timings.DNS, timings.Connect, timings.Send, timings.Wait, timings.Receive} {
+		if phase > 0 {
+			total += phase
+		}
+	}
+
+	rec.entry.Time = total
+}
+
+func toHARPhase(start, end float64) float64 {
+	if start < 0 || end < 0 {
+		return -1
+	}
+
+	return toHARDuration(end - start)
+}
+
+func toHARDuration(value float64) float64 {
+	if value < 0 {
+		return 0
+	}
+
+	return value
+}
+
+func toHARHeaders(headers network.Headers) []HARNameValue {
+	res := make([]HARNameValue, 0, 10)
+
+	toDriverHeaders(headers).ForEach(func(value []string, key string) bool {
+		for _, v := range value {
+			res = append(res, HARNameValue{Name: key, Value: v})
+		}
+
+		return true
+	})
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+func toHARQueryString(rawURL string) []HARNameValue {
+	res := make([]HARNameValue, 0, 5)
+	u, err := url.Parse(rawURL)
+
+	if err != nil {
+		return res
+	}
+
+	for key, values := range u.Query() {
+		for _, value := range values {
+			res = append(res, HARNameValue{Name: key, Value: value})
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+func findHARHeader(headers []HARNameValue, name string) string {
+	for _, h := range headers {
+		if http.CanonicalHeaderKey(h.Name) == http.CanonicalHeaderKey(name) {
+			return h.Value
+		}
+	}
+
+	return ""
+}
+
+// moduleVersion returns a version of the ferret module the binary is built with.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+
+	if !ok {
+		return "dev"
+	}
+
+	if info.Main.Path == ferretModule && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == ferretModule {
+			return dep.Version
+		}
+	}
+
+	return "dev"
+}
@@ -0,0 +1,131 @@
+package network
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/rs/zerolog"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+func TestHARRecorder(t *testing.T) {
+	Convey("Should record requests with their responses and timings", t, func() {
+		r := newHARRecorder(zerolog.Nop(), nil, drivers.HARParams{})
+
+		r.handleRequest(&network.RequestWillBeSentReply{
+			RequestID: "1",
+			Timestamp: 100,
+			WallTime:  1600000000,
+			Request: network.Request{
+				Method:  "GET",
+				URL:     "https://example.com/?q=ferret",
+				Headers: network.Headers(`{"Accept":"text/html"}`),
+			},
+		})
+
+		r.handleResponse(&network.ResponseReceivedReply{
+			RequestID: "1",
+			Response: network.Response{
+				Status:     200,
+				StatusText: "OK",
+				MimeType:   "text/html",
+				Headers:    network.Headers(`{"Content-Type":"text/html"}`),
+				Timing: &network.ResourceTiming{
+					RequestTime:       100,
+					DNSStart:          0,
+					DNSEnd:            10,
+					ConnectStart:      10,
+					ConnectEnd:        30,
+					SSLStart:          -1,
+					SSLEnd:            -1,
+					SendStart:         30,
+					SendEnd:           31,
+					ReceiveHeadersEnd: 81,
+				},
+			},
+		})
+
+		r.handleFinished(&network.LoadingFinishedReply{
+			RequestID:         "1",
+			Timestamp:         100.1,
+			EncodedDataLength: 512,
+		})
+
+		har := r.HAR(context.Background())
+
+		So(har.Log.Version, ShouldEqual, "1.2")
+		So(har.Log.Entries, ShouldHaveLength, 1)
+
+		entry := har.Log.Entries[0]
+
+		So(entry.Request.URL, ShouldEqual, "https://example.com/?q=ferret")
+		So(entry.Request.QueryString, ShouldResemble, []HARNameValue{{Name: "q", Value: "ferret"}})
+		So(entry.Response.Status, ShouldEqual, 200)
+		So(entry.Response.Content.Size, ShouldEqual, 512)
+		So(entry.Timings.DNS, ShouldEqual, 10)
+		So(entry.Timings.Connect, ShouldEqual, 20)
+		So(entry.Timings.SSL, ShouldEqual, -1)
+		So(entry.Timings.Wait, ShouldEqual, 50)
+		So(entry.Timings.Receive, ShouldAlmostEqual, 19, 0.001)
+		So(entry.Time, ShouldAlmostEqual, 100, 0.001)
+	})
+
+	Convey("Should record redirects and failures as separate entries", t, func() {
+		r := newHARRecorder(zerolog.Nop(), nil, drivers.HARParams{})
+
+		r.handleRequest(&network.RequestWillBeSentReply{
+			RequestID: "1",
+			Timestamp: 1,
+			Request:   network.Request{Method: "GET", URL: "http://example.com"},
+		})
+
+		r.handleRequest(&network.RequestWillBeSentReply{
+			RequestID:        "1",
+			Timestamp:        2,
+			Request:          network.Request{Method: "GET", URL: "https://example.com"},
+			RedirectResponse: &network.Response{Status: 301, StatusText: "Moved Permanently"},
+		})
+
+		r.handleFailed(&network.LoadingFailedReply{
+			RequestID: "1",
+			Timestamp: 3,
+			ErrorText: "net::ERR_FAILED",
+		})
+
+		har := r.HAR(context.Background())
+
+		So(har.Log.Entries, ShouldHaveLength, 2)
+		So(har.Log.Entries[0].Response.Status, ShouldEqual, 301)
+		So(har.Log.Entries[0].Response.RedirectURL, ShouldEqual, "https://example.com")
+		So(har.Log.Entries[1].Comment, ShouldEqual, "net::ERR_FAILED")
+	})
+
+	Convey("Should queue response bodies without blocking finished requests", t, func() {
+		r := newHARRecorder(zerolog.Nop(), nil, drivers.HARParams{Content: true})
+
+		for i := 0; i < harBodyQueueSize+1; i++ {
+			id := network.RequestID(strconv.Itoa(i))
+
+			r.handleRequest(&network.RequestWillBeSentReply{
+				RequestID: id,
+				Request:   network.Request{Method: "GET", URL: "https://example.com"},
+			})
+
+			r.handleFinished(&network.LoadingFinishedReply{RequestID: id})
+		}
+
+		So(r.loading, ShouldEqual, harBodyQueueSize)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		har := r.HAR(ctx)
+
+		So(har.Log.Creator.Version, ShouldNotBeEmpty)
+		So(har.Log.Entries, ShouldHaveLength, harBodyQueueSize+1)
+	})
+}
@@ -2,10 +2,12 @@ package network
 
 import (
 	"encoding/json"
+	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/mafredri/cdp/protocol/fetch"
 	"github.com/mafredri/cdp/protocol/network"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/rs/zerolog/log"
@@ -157,3 +159,69 @@ func isFrameMatched(current, target page.FrameID) bool {
 
 	return target == current
 }
+
+func toHeaderEntries(headers *drivers.HTTPHeaders) []fetch.HeaderEntry {
+	entries := make([]fetch.HeaderEntry, 0, headers.Length())
+
+	headers.ForEach(func(value []string, key string) bool {
+		for _, v := range value {
+			entries = append(entries, fetch.HeaderEntry{
+				Name:  key,
+				Value: v,
+			})
+		}
+
+		return true
+	})
+
+	return entries
+}
+
+func toFulfillRequestArgs(id fetch.RequestID, resp *drivers.InterceptResponse) *fetch.FulfillRequestArgs {
+	code := resp.StatusCode
+
+	if code == 0 {
+		code = http.StatusOK
+	}
+
+	args := fetch.NewFulfillRequestArgs(id, code)
+
+	if resp.Headers != nil && resp.Headers.Length() > 0 {
+		args.SetResponseHeaders(toHeaderEntries(resp.Headers))
+	}
+
+	if resp.Body != nil {
+		args.SetBody(resp.Body)
+	}
+
+	return args
+}
+
+func toContinueRequestArgs(args *fetch.ContinueRequestArgs, original network.Request, req *drivers.InterceptRequest) *fetch.ContinueRequestArgs {
+	if req.URL != "" {
+		args.SetURL(req.URL)
+	}
+
+	if req.Method != "" {
+		args.SetMethod(req.Method)
+	}
+
+	if req.Body != nil {
+		args.SetPostData(req.Body)
+	}
+
+	if req.Headers != nil && req.Headers.Length() > 0 {
+		// the headers override the original ones, therefore they need to be merged
+		headers := toDriverHeaders(original.Headers)
+
+		req.Headers.ForEach(func(value []string, key string) bool {
+			headers.SetArr(key, value)
+
+			return true
+		})
+
+		args.SetHeaders(toHeaderEntries(headers))
+	}
+
+	return args
+}
@@ -27,6 +27,7 @@ type (
 		logger  zerolog.Logger
 		client  *cdp.Client
 		filters map[string]*InterceptorFilter
+		rules   []*InterceptorRule
 		loop    *events.Loop
 	}
 
@@ -34,6 +35,13 @@ type (
 		resources []ResourceFilter
 	}
 
+	InterceptorRule struct {
+		url          glob.Glob
+		resourceType string
+		request      *drivers.InterceptRequest
+		response     *drivers.InterceptResponse
+	}
+
 	InterceptorListener func(ctx context.Context, msg *fetch.RequestPausedReply) bool
 )
 
@@ -84,6 +92,37 @@ func (f *InterceptorFilter) Filter(rt network.ResourceType, req network.Request)
 	return result
 }
 
+func NewInterceptorRule(rule drivers.InterceptRule) (*InterceptorRule, error) {
+	r := new(InterceptorRule)
+	r.resourceType = rule.ResourceType
+	r.request = rule.Request
+	r.response = rule.Response
+
+	if rule.URL != "" {
+		p, err := glob.Compile(rule.URL)
+
+		if err != nil {
+			return nil, err
+		}
+
+		r.url = p
+	}
+
+	return r, nil
+}
+
+func (r *InterceptorRule) Match(rt network.ResourceType, req network.Request) bool {
+	if r.resourceType != "" && string(rt) != r.resourceType && rt != toResourceType(r.resourceType) {
+		return false
+	}
+
+	if r.url != nil && !r.url.Match(req.URL) {
+		return false
+	}
+
+	return true
+}
+
 func NewInterceptor(logger zerolog.Logger, client *cdp.Client) *Interceptor {
 	i := new(Interceptor)
 	i.logger = logging.WithName(logger.With(), "network_interceptor").Logger()
@@ -117,6 +156,23 @@ func (i *Interceptor) AddFilter(name string, filter *Filter) error {
 	return nil
 }
 
+// AddRule adds a rule for handling matching requests.
+// Rules added later take precedence over the earlier ones.
+func (i *Interceptor) AddRule(rule drivers.InterceptRule) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	r, err := NewInterceptorRule(rule)
+
+	if err != nil {
+		return err
+	}
+
+	i.rules = append(i.rules, r)
+
+	return nil
+}
+
 func (i *Interceptor) RemoveFilter(name string) {
 	i.mu.Lock()
 	defer i.mu.Unlock()
@@ -222,22 +278,62 @@ func (i *Interceptor) filter(ctx context.Context, message interface{}) {
 	}
 
 	if !reject {
-		err := i.client.Fetch.ContinueRequest(ctx, fetch.NewContinueRequestArgs(msg.RequestID))
+		i.handle(ctx, log, msg)
+
+		return
+	}
+
+	err := i.client.Fetch.FailRequest(ctx, fetch.NewFailRequestArgs(msg.RequestID, network.ErrorReasonBlockedByClient))
+
+	if err != nil {
+		log.Trace().Err(err).Msg("failed to block resource loading")
+	}
+
+	log.Trace().Msg("succeeded to block resource loading")
+}
+
+func (i *Interceptor) handle(ctx context.Context, log zerolog.Logger, msg *fetch.RequestPausedReply) {
+	var rule *InterceptorRule
+
+	for idx := len(i.rules) - 1; idx >= 0; idx-- {
+		if i.rules[idx].Match(msg.ResourceType, msg.Request) {
+			rule = i.rules[idx]
+
+			break
+		}
+	}
+
+	if rule != nil && rule.response != nil {
+		log.Trace().Int("status_code", rule.response.StatusCode).Msg("fulfilling request with mocked response")
+
+		err := i.client.Fetch.FulfillRequest(ctx, toFulfillRequestArgs(msg.RequestID, rule.response))
 
 		if err != nil {
-			i.logger.Err(err).Msg("failed to allow resource loading")
+			log.Trace().Err(err).Msg("failed to fulfill request")
+
+			return
 		}
 
-		log.Trace().Msg("succeeded to allow resource loading")
+		log.Trace().Msg("succeeded to fulfill request")
 
 		return
 	}
 
-	err := i.client.Fetch.FailRequest(ctx, fetch.NewFailRequestArgs(msg.RequestID, network.ErrorReasonBlockedByClient))
+	args := fetch.NewContinueRequestArgs(msg.RequestID)
+
+	if rule != nil && rule.request != nil {
+		log.Trace().Msg("modifying request")
+
+		args = toContinueRequestArgs(args, msg.Request, rule.request)
+	}
+
+	err := i.client.Fetch.ContinueRequest(ctx, args)
 
 	if err != nil {
-		log.Trace().Err(err).Msg("failed to block resource loading")
+		i.logger.Err(err).Msg("failed to allow resource loading")
+
+		return
 	}
 
-	log.Trace().Msg("succeeded to block resource loading")
+	log.Trace().Msg("succeeded to allow resource loading")
 }
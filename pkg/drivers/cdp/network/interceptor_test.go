@@ -0,0 +1,39 @@
+package network_test
+
+import (
+	"testing"
+
+	network2 "github.com/mafredri/cdp/protocol/network"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/network"
+)
+
+func TestInterceptorRule(t *testing.T) {
+	Convey("Should match requests by url pattern and resource type", t, func() {
+		rule, err := network.NewInterceptorRule(drivers.InterceptRule{
+			URL:          "https://example.com/api/*",
+			ResourceType: "xhr",
+			Response:     &drivers.InterceptResponse{StatusCode: 200},
+		})
+
+		So(err, ShouldBeNil)
+
+		api := network2.Request{URL: "https://example.com/api/users"}
+		page := network2.Request{URL: "https://example.com/users"}
+
+		So(rule.Match(network2.ResourceTypeXHR, api), ShouldBeTrue)
+		So(rule.Match(network2.ResourceTypeFetch, api), ShouldBeFalse)
+		So(rule.Match(network2.ResourceTypeXHR, page), ShouldBeFalse)
+	})
+
+	Convey("Should match all requests when no criteria are set", t, func() {
+		rule, err := network.NewInterceptorRule(drivers.InterceptRule{
+			Request: &drivers.InterceptRequest{Method: "POST"},
+		})
+
+		So(err, ShouldBeNil)
+		So(rule.Match(network2.ResourceTypeDocument, network2.Request{URL: "https://example.com"}), ShouldBeTrue)
+	})
+}
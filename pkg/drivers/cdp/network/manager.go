@@ -31,6 +31,9 @@ type (
 		headers     *drivers.HTTPHeaders
 		loop        *events.Loop
 		interceptor *Interceptor
+		har         *harRecorder
+		loading     *loadingTracker
+		ctx         context.Context
 		stop        context.CancelFunc
 		response    *sync.Map
 	}
@@ -47,30 +50,59 @@ func New(
 	m.logger = logging.WithName(logger.With(), "network_manager").Logger()
 	m.client = client
 	m.headers = drivers.NewHTTPHeaders()
+	m.ctx = ctx
 	m.stop = cancel
 	m.response = new(sync.Map)
+	m.loading = newLoadingTracker()
 
 	var err error
 
 	defer func() {
 		if err != nil {
 			m.stop()
+			m.loading.Stop()
 		}
 	}()
 
 	m.loop = events.NewLoop(
 		createResponseReceivedStreamFactory(client),
+		createLoadingFinishedStreamFactory(client),
+		createLoadingFailedStreamFactory(client),
 	)
 
 	m.loop.AddListener(responseReceivedEvent, m.handleResponse)
+	m.loop.AddListener(loadingFinishedEvent, m.handleLoadingFinished)
+	m.loop.AddListener(loadingFailedEvent, m.handleLoadingFailed)
+
+	if options.HAR != nil {
+		m.har = newHARRecorder(m.logger, client, *options.HAR)
+
+		if err = m.har.Run(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	if options.Filter != nil && len(options.Filter.Patterns) > 0 {
 		m.interceptor = NewInterceptor(logger, client)
 
-		if err := m.interceptor.AddFilter("resources", options.Filter); err != nil {
+		if err = m.interceptor.AddFilter("resources", options.Filter); err != nil {
 			return nil, err
 		}
+	}
+
+	if len(options.Rules) > 0 {
+		if m.interceptor == nil {
+			m.interceptor = NewInterceptor(logger, client)
+		}
+
+		for _, rule := range options.Rules {
+			if err = m.interceptor.AddRule(rule); err != nil {
+				return nil, err
+			}
+		}
+	}
 
+	if m.interceptor != nil {
 		if err = m.interceptor.Run(ctx); err != nil {
 			return nil, err
 		}
@@ -112,9 +144,39 @@ func (m *Manager) Close() error {
 		m.stop = nil
 	}
 
+	m.loading.Stop()
+
 	return nil
 }
 
+// HAR returns network traffic recorded since the page was opened.
+func (m *Manager) HAR(ctx context.Context) (HAR, error) {
+	if m.har == nil {
+		return HAR{}, errors.Wrap(core.ErrInvalidOperation, "HAR recording is not enabled")
+	}
+
+	return m.har.HAR(ctx), nil
+}
+
+// Intercept adds rules for handling matching requests.
+// Request interception gets started with the first added rule, if it is not running yet.
+func (m *Manager) Intercept(_ context.Context, rules ...drivers.InterceptRule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.interceptor == nil {
+		m.interceptor = NewInterceptor(m.logger, m.client)
+	}
+
+	for _, rule := range rules {
+		if err := m.interceptor.AddRule(rule); err != nil {
+			return err
+		}
+	}
+
+	return m.interceptor.Run(m.ctx)
+}
+
 func (m *Manager) GetCookies(ctx context.Context) (*drivers.HTTPCookies, error) {
 	m.logger.Trace().Msg("starting to get cookies")
 
@@ -577,7 +639,7 @@ func (m *Manager) OnResponse(ctx context.Context) (rtEvents.Stream, error) {
 
 	m.logger.Trace().Msg("succeeded to receive response events")
 
-	return newResponseReceivedReader(m.logger, m.client, stream), nil
+	return newResponseReceivedReader(m.logger, m.client, m.loading, stream), nil
 }
 
 func (m *Manager) handleResponse(_ context.Context, message interface{}) (out bool) {
@@ -615,3 +677,25 @@ func (m *Manager) handleResponse(_ context.Context, message interface{}) (out bo
 
 	return
 }
+
+func (m *Manager) handleLoadingFinished(_ context.Context, message interface{}) bool {
+	if msg, ok := message.(*network.LoadingFinishedReply); ok {
+		m.loading.Complete(msg.RequestID, "")
+	}
+
+	return true
+}
+
+func (m *Manager) handleLoadingFailed(_ context.Context, message interface{}) bool {
+	if msg, ok := message.(*network.LoadingFailedReply); ok {
+		errorText := msg.ErrorText
+
+		if errorText == "" {
+			errorText = "unknown error"
+		}
+
+		m.loading.Complete(msg.RequestID, errorText)
+	}
+
+	return true
+}
@@ -29,6 +29,8 @@ type (
 		mock.Mock
 		cdp.Network
 		responseReceived    func(ctx context.Context) (network2.ResponseReceivedClient, error)
+		loadingFinished     func(ctx context.Context) (network2.LoadingFinishedClient, error)
+		loadingFailed       func(ctx context.Context) (network2.LoadingFailedClient, error)
 		setExtraHTTPHeaders func(ctx context.Context, args *network2.SetExtraHTTPHeadersArgs) error
 	}
 
@@ -54,6 +56,14 @@ type (
 		*TestEventStream
 	}
 
+	LoadingFinishedClient struct {
+		*TestEventStream
+	}
+
+	LoadingFailedClient struct {
+		*TestEventStream
+	}
+
 	RequestPausedClient struct {
 		*TestEventStream
 	}
@@ -67,6 +77,14 @@ func (api *NetworkAPI) ResponseReceived(ctx context.Context) (network2.ResponseR
 	return api.responseReceived(ctx)
 }
 
+func (api *NetworkAPI) LoadingFinished(ctx context.Context) (network2.LoadingFinishedClient, error) {
+	return api.loadingFinished(ctx)
+}
+
+func (api *NetworkAPI) LoadingFailed(ctx context.Context) (network2.LoadingFailedClient, error) {
+	return api.loadingFailed(ctx)
+}
+
 func (api *NetworkAPI) SetExtraHTTPHeaders(ctx context.Context, args *network2.SetExtraHTTPHeadersArgs) error {
 	return api.setExtraHTTPHeaders(ctx, args)
 }
@@ -164,6 +182,44 @@ func (stream *ResponseReceivedClient) Recv() (*network2.ResponseReceivedReply, e
 	return repl, nil
 }
 
+func NewLoadingFinishedClient() *LoadingFinishedClient {
+	return &LoadingFinishedClient{
+		TestEventStream: NewTestEventStream(),
+	}
+}
+
+func (stream *LoadingFinishedClient) Recv() (*network2.LoadingFinishedReply, error) {
+	<-stream.Ready()
+	msg := stream.Message()
+
+	repl, ok := msg.(*network2.LoadingFinishedReply)
+
+	if !ok {
+		panic("Invalid message type")
+	}
+
+	return repl, nil
+}
+
+func NewLoadingFailedClient() *LoadingFailedClient {
+	return &LoadingFailedClient{
+		TestEventStream: NewTestEventStream(),
+	}
+}
+
+func (stream *LoadingFailedClient) Recv() (*network2.LoadingFailedReply, error) {
+	<-stream.Ready()
+	msg := stream.Message()
+
+	repl, ok := msg.(*network2.LoadingFailedReply)
+
+	if !ok {
+		panic("Invalid message type")
+	}
+
+	return repl, nil
+}
+
 func NewRequestPausedClient() *RequestPausedClient {
 	return &RequestPausedClient{
 		TestEventStream: NewTestEventStream(),
@@ -194,6 +250,16 @@ func TestManager(t *testing.T) {
 				networkAPI.responseReceived = func(ctx context.Context) (network2.ResponseReceivedClient, error) {
 					return responseReceivedClient, nil
 				}
+				loadingFinishedClient := NewLoadingFinishedClient()
+				loadingFinishedClient.On("Close", mock.Anything).Once().Return(nil)
+				networkAPI.loadingFinished = func(ctx context.Context) (network2.LoadingFinishedClient, error) {
+					return loadingFinishedClient, nil
+				}
+				loadingFailedClient := NewLoadingFailedClient()
+				loadingFailedClient.On("Close", mock.Anything).Once().Return(nil)
+				networkAPI.loadingFailed = func(ctx context.Context) (network2.LoadingFailedClient, error) {
+					return loadingFailedClient, nil
+				}
 				networkAPI.setExtraHTTPHeaders = func(ctx context.Context, args *network2.SetExtraHTTPHeadersArgs) error {
 					return nil
 				}
@@ -235,6 +301,8 @@ func TestManager(t *testing.T) {
 				time.Sleep(time.Duration(100) * time.Millisecond)
 
 				responseReceivedClient.AssertExpectations(t)
+				loadingFinishedClient.AssertExpectations(t)
+				loadingFailedClient.AssertExpectations(t)
 				requestPausedClient.AssertExpectations(t)
 			})
 		})
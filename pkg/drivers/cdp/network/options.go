@@ -19,6 +19,8 @@ type (
 		Cookies Cookies
 		Headers *drivers.HTTPHeaders
 		Filter  *Filter
+		Rules   []drivers.InterceptRule
+		HAR     *drivers.HARParams
 	}
 
 	WaitEventOptions struct {
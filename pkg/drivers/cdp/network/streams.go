@@ -2,7 +2,6 @@ package network
 
 import (
 	"context"
-	"encoding/base64"
 	"sync/atomic"
 
 	"github.com/mafredri/cdp"
@@ -202,7 +201,7 @@ func newRequestWillBeSentStream(logger zerolog.Logger, input network.RequestWill
 	})
 }
 
-func newResponseReceivedReader(logger zerolog.Logger, client *cdp.Client, input network.ResponseReceivedClient) rtEvents.Stream {
+func newResponseReceivedReader(logger zerolog.Logger, client *cdp.Client, tracker *loadingTracker, input network.ResponseReceivedClient) rtEvents.Stream {
 	return events.NewEventStream(input, func(ctx context.Context, stream rpcc.Stream) (core.Value, error) {
 		repl, err := stream.(network.ResponseReceivedClient).Recv()
 
@@ -225,36 +224,9 @@ func newResponseReceivedReader(logger zerolog.Logger, client *cdp.Client, input
 			Interface("data", repl.Response).
 			Msg("received response event")
 
-		var body []byte
+		resp := toDriverResponse(repl.Response, nil)
+		resp.LoadBody = newResponseBodyLoader(logger, client, tracker, repl.RequestID)
 
-		resp, err := client.Network.GetResponseBody(ctx, network.NewGetResponseBodyArgs(repl.RequestID))
-
-		if err == nil {
-			body = make([]byte, 0, 0)
-
-			if resp.Base64Encoded {
-				body, err = base64.StdEncoding.DecodeString(resp.Body)
-
-				if err != nil {
-					logger.Warn().
-						Str("url", repl.Response.URL).
-						Str("frame_id", frameID).
-						Str("request_id", string(repl.RequestID)).
-						Interface("data", repl.Response).
-						Msg("failed to decode response body")
-				}
-			} else {
-				body = []byte(resp.Body)
-			}
-		} else {
-			logger.Warn().
-				Str("url", repl.Response.URL).
-				Str("frame_id", frameID).
-				Str("request_id", string(repl.RequestID)).
-				Interface("data", repl.Response).
-				Msg("failed to get response body")
-		}
-
-		return toDriverResponse(repl.Response, body), nil
+		return resp, nil
 	})
 }
@@ -10,6 +10,8 @@ type (
 		Address     string
 		KeepCookies bool
 		Connection  *ConnectionOptions
+		// Launcher enables launching of a local browser instead of connecting to Address.
+		Launcher *LauncherOptions
 	}
 
 	ConnectionOptions struct {
@@ -50,6 +52,14 @@ func WithAddress(address string) Option {
 	}
 }
 
+// WithLauncher makes the driver launch a local Chrome or Chromium on the first use
+// and kill it when the driver gets closed. The address option is ignored.
+func WithLauncher(options LauncherOptions) Option {
+	return func(opts *Options) {
+		opts.Launcher = &options
+	}
+}
+
 func WithProxy(address string) Option {
 	return func(opts *Options) {
 		drivers.WithProxy(address)(opts.Options)
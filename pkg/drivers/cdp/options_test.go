@@ -58,6 +58,7 @@ func TestNewOptions(t *testing.T) {
 			cdp.WithHeaders(drivers.NewHTTPHeadersWith(map[string][]string{
 				"x-correlation-id": {"232483833833839"},
 			})),
+			cdp.WithLauncher(cdp.LauncherOptions{Headful: true}),
 		})
 		So(opts.Options, ShouldNotBeNil)
 		So(opts.Name, ShouldEqual, expectedName)
@@ -67,5 +68,7 @@ func TestNewOptions(t *testing.T) {
 		So(opts.KeepCookies, ShouldBeTrue)
 		So(opts.Cookies.Length(), ShouldEqual, 2)
 		So(opts.Headers.Length(), ShouldEqual, 2)
+		So(opts.Launcher, ShouldNotBeNil)
+		So(opts.Launcher.Headful, ShouldBeTrue)
 	})
 }
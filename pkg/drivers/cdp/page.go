@@ -2,6 +2,7 @@ package cdp
 
 import (
 	"context"
+	"encoding/json"
 	"hash/fnv"
 	"io"
 	"regexp"
@@ -14,11 +15,14 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/console"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/dialog"
 	"github.com/MontFerret/ferret/pkg/drivers/cdp/dom"
 	"github.com/MontFerret/ferret/pkg/drivers/cdp/input"
 	net "github.com/MontFerret/ferret/pkg/drivers/cdp/network"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/perf"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/storage"
 	"github.com/MontFerret/ferret/pkg/drivers/cdp/templates"
-	"github.com/MontFerret/ferret/pkg/drivers/cdp/utils"
 	"github.com/MontFerret/ferret/pkg/drivers/common"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/events"
@@ -37,6 +41,11 @@ type (
 		client  *cdp.Client
 		network *net.Manager
 		dom     *dom.Manager
+		dialog  *dialog.Manager
+		console *console.Manager
+		storage *storage.Manager
+		perf    *perf.Manager
+		targets *targetManager
 	}
 )
 
@@ -56,6 +65,10 @@ func LoadHTMLPage(
 		return nil, err
 	}
 
+	if err := emulate(ctx, client, params.Emulation); err != nil {
+		return nil, err
+	}
+
 	closers := make([]io.Closer, 0, 4)
 
 	defer func() {
@@ -87,6 +100,14 @@ func LoadHTMLPage(
 		}
 	}
 
+	if params.HAR != nil {
+		netOpts.HAR = params.HAR
+	}
+
+	if len(params.Intercept) > 0 {
+		netOpts.Rules = params.Intercept
+	}
+
 	netManager, err := net.New(
 		logger,
 		client,
@@ -97,8 +118,67 @@ func LoadHTMLPage(
 		return nil, err
 	}
 
-	mouse := input.NewMouse(client)
-	keyboard := input.NewKeyboard(client)
+	closers = append(closers, netManager)
+
+	var dialogOpts drivers.Dialog
+
+	if params.Dialog != nil {
+		dialogOpts = *params.Dialog
+	}
+
+	dialogManager, err := dialog.New(
+		logger,
+		client,
+		dialogOpts,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	closers = append(closers, dialogManager)
+
+	var consoleOpts drivers.Console
+
+	if params.Console != nil {
+		consoleOpts = *params.Console
+	}
+
+	consoleManager, err := console.New(
+		logger,
+		client,
+		consoleOpts,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	closers = append(closers, consoleManager)
+
+	storageManager := storage.New(logger, client)
+	perfManager := perf.New(logger, client)
+
+	var preloadID page.ScriptIdentifier
+
+	// storage items are set by the target document itself, since the browser does not keep
+	// the storage of an origin that has not been loaded yet
+	if params.Storage != nil && params.URL != BlankPageURL && params.URL != "" {
+		preloadID, err = storageManager.Preload(ctx, params.URL, *params.Storage)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := input.NewProfile(params.InputProfile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mouse := input.NewMouse(client, profile)
+	keyboard := input.NewKeyboard(client, profile)
 
 	domManager, err := dom.New(
 		logger,
@@ -117,6 +197,10 @@ func LoadHTMLPage(
 		client,
 		netManager,
 		domManager,
+		dialogManager,
+		consoleManager,
+		storageManager,
+		perfManager,
 	)
 
 	if params.URL != BlankPageURL && params.URL != "" {
@@ -129,6 +213,12 @@ func LoadHTMLPage(
 		return p, err
 	}
 
+	if preloadID != "" {
+		if err = storageManager.RemovePreload(ctx, preloadID); err != nil {
+			return p, err
+		}
+	}
+
 	return p, nil
 }
 
@@ -183,6 +273,10 @@ func NewHTMLPage(
 	client *cdp.Client,
 	netManager *net.Manager,
 	domManager *dom.Manager,
+	dialogManager *dialog.Manager,
+	consoleManager *console.Manager,
+	storageManager *storage.Manager,
+	perfManager *perf.Manager,
 ) *HTMLPage {
 	p := new(HTMLPage)
 	p.closed = values.False
@@ -191,6 +285,10 @@ func NewHTMLPage(
 	p.client = client
 	p.network = netManager
 	p.dom = domManager
+	p.dialog = dialogManager
+	p.console = consoleManager
+	p.storage = storageManager
+	p.perf = perfManager
 
 	return p
 }
@@ -265,6 +363,10 @@ func (p *HTMLPage) Close() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
+	if p.closed {
+		return nil
+	}
+
 	var url string
 	frame := p.dom.GetMainFrame()
 
@@ -274,6 +376,15 @@ func (p *HTMLPage) Close() error {
 
 	p.closed = values.True
 
+	if p.targets != nil {
+		if err := p.targets.Close(); err != nil {
+			p.logger.Warn().
+				Str("url", url).
+				Err(err).
+				Msg("failed to close child pages")
+		}
+	}
+
 	err := p.dom.Close()
 
 	if err != nil {
@@ -292,6 +403,24 @@ func (p *HTMLPage) Close() error {
 			Msg("failed to close network manager")
 	}
 
+	err = p.dialog.Close()
+
+	if err != nil {
+		p.logger.Warn().
+			Str("url", url).
+			Err(err).
+			Msg("failed to close dialog manager")
+	}
+
+	err = p.console.Close()
+
+	if err != nil {
+		p.logger.Warn().
+			Str("url", url).
+			Err(err).
+			Msg("failed to close console manager")
+	}
+
 	err = p.client.Page.Close(context.Background())
 
 	if err != nil {
@@ -352,6 +481,28 @@ func (p *HTMLPage) GetFrame(ctx context.Context, idx values.Int) (core.Value, er
 	return frames.Get(idx), nil
 }
 
+func (p *HTMLPage) GetPages(_ context.Context) (*values.Array, error) {
+	targets := p.getTargets()
+
+	if targets == nil {
+		return toPageArray(p, nil), nil
+	}
+
+	return toPageArray(p, targets.Pages()), nil
+}
+
+// getTargets returns a manager of pages opened by the page, if they are tracked.
+func (p *HTMLPage) getTargets() *targetManager {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.targets
+}
+
+func (p *HTMLPage) BringToFront(ctx context.Context) error {
+	return p.client.Page.BringToFront(ctx)
+}
+
 func (p *HTMLPage) GetCookies(ctx context.Context) (*drivers.HTTPCookies, error) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -373,6 +524,79 @@ func (p *HTMLPage) DeleteCookies(ctx context.Context, cookies *drivers.HTTPCooki
 	return p.network.DeleteCookies(ctx, p.getCurrentDocument().GetURL().String(), cookies)
 }
 
+func (p *HTMLPage) GetStorage(ctx context.Context, storageType drivers.StorageType) (*values.Object, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.storage.GetItems(ctx, p.getCurrentDocument().GetURL().String(), storageType)
+}
+
+func (p *HTMLPage) SetStorage(ctx context.Context, storageType drivers.StorageType, items *values.Object) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if items == nil {
+		return core.Error(core.ErrMissedArgument, "storage items")
+	}
+
+	entries := make(map[string]string, items.Length())
+
+	items.ForEach(func(value core.Value, key string) bool {
+		entries[key] = value.String()
+
+		return true
+	})
+
+	return p.storage.SetItems(ctx, p.getCurrentDocument().GetURL().String(), storageType, entries)
+}
+
+func (p *HTMLPage) ClearStorage(ctx context.Context, storageType drivers.StorageType) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.storage.Clear(ctx, p.getCurrentDocument().GetURL().String(), storageType)
+}
+
+func (p *HTMLPage) GetIndexedDBNames(ctx context.Context) (*values.Array, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.storage.GetIndexedDBNames(ctx, p.getCurrentDocument().GetURL().String())
+}
+
+func (p *HTMLPage) GetIndexedDBItems(ctx context.Context, database, store values.String) (*values.Array, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.storage.GetIndexedDBItems(ctx, p.getCurrentDocument().GetURL().String(), database.String(), store.String())
+}
+
+func (p *HTMLPage) GetHAR(ctx context.Context) (*values.Object, error) {
+	har, err := p.network.HAR(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := json.Marshal(har)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal HAR")
+	}
+
+	val, err := values.Unmarshal(out)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal HAR")
+	}
+
+	return val.(*values.Object), nil
+}
+
+func (p *HTMLPage) Intercept(ctx context.Context, rules ...drivers.InterceptRule) error {
+	return p.network.Intercept(ctx, rules...)
+}
+
 func (p *HTMLPage) GetResponse(ctx context.Context) (drivers.HTTPResponse, error) {
 	doc := p.getCurrentDocument()
 
@@ -448,57 +672,35 @@ func (p *HTMLPage) CaptureScreenshot(ctx context.Context, params drivers.Screens
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	metrics, err := p.client.Page.GetLayoutMetrics(ctx)
-
-	if err != nil {
-		return values.NewBinary(nil), err
-	}
-
-	if params.Format == drivers.ScreenshotFormatJPEG && params.Quality < 0 && params.Quality > 100 {
-		params.Quality = 100
-	}
-
-	if params.X < 0 {
-		params.X = 0
-	}
-
-	if params.Y < 0 {
-		params.Y = 0
-	}
+	return p.dom.CaptureScreenshot(ctx, nil, params)
+}
 
-	clientWidth, clientHeight := utils.GetLayoutViewportWH(metrics)
+func (p *HTMLPage) GetAccessibilityTree(ctx context.Context, params drivers.AccessibilityParams) (*values.Object, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	if params.Width <= 0 {
-		params.Width = values.Float(clientWidth) - params.X
-	}
+	return p.dom.GetAccessibilityTree(ctx, nil, params)
+}
 
-	if params.Height <= 0 {
-		params.Height = values.Float(clientHeight) - params.Y
-	}
+func (p *HTMLPage) GetMetrics(ctx context.Context) (*values.Object, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	clip := page.Viewport{
-		X:      float64(params.X),
-		Y:      float64(params.Y),
-		Width:  float64(params.Width),
-		Height: float64(params.Height),
-		Scale:  1.0,
-	}
+	return p.perf.GetMetrics(ctx, p.getCurrentDocument().Eval())
+}
 
-	format := string(params.Format)
-	quality := int(params.Quality)
-	args := page.CaptureScreenshotArgs{
-		Format:  &format,
-		Quality: &quality,
-		Clip:    &clip,
-	}
+func (p *HTMLPage) StartCoverage(ctx context.Context, detailed values.Boolean) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	reply, err := p.client.Page.CaptureScreenshot(ctx, &args)
+	return p.perf.StartCoverage(ctx, bool(detailed))
+}
 
-	if err != nil {
-		return values.NewBinary([]byte{}), err
-	}
+func (p *HTMLPage) StopCoverage(ctx context.Context) (*values.Array, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	return values.NewBinary(reply.Data), nil
+	return p.perf.StopCoverage(ctx)
 }
 
 func (p *HTMLPage) Navigate(ctx context.Context, url values.String) error {
@@ -591,6 +793,10 @@ func (p *HTMLPage) WaitForFrameNavigation(ctx context.Context, frame drivers.HTM
 	return p.reloadMainFrame(ctx)
 }
 
+func (p *HTMLPage) HandleDialog(ctx context.Context, accept values.Boolean, promptText values.String) error {
+	return p.dialog.Handle(ctx, bool(accept), promptText.String())
+}
+
 func (p *HTMLPage) Subscribe(ctx context.Context, subscription events.Subscription) (events.Stream, error) {
 	switch subscription.EventName {
 	case drivers.NavigationEvent:
@@ -610,6 +816,22 @@ func (p *HTMLPage) Subscribe(ctx context.Context, subscription events.Subscripti
 		return p.network.OnRequest(ctx)
 	case drivers.ResponseEvent:
 		return p.network.OnResponse(ctx)
+	case drivers.DialogEvent:
+		return p.dialog.OnDialog(ctx)
+	case drivers.ConsoleEvent:
+		return p.console.OnConsole(ctx)
+	case drivers.ErrorEvent:
+		return p.console.OnError(ctx)
+	case drivers.MetricsEvent:
+		return p.perf.OnMetrics(ctx)
+	case drivers.PageEvent:
+		targets := p.getTargets()
+
+		if targets == nil {
+			return nil, core.Error(core.ErrNotSupported, "page tracking is not enabled")
+		}
+
+		return targets.OnPage()
 	default:
 		return nil, core.Errorf(core.ErrInvalidOperation, "unknown event name: %s", subscription.EventName)
 	}
@@ -0,0 +1,95 @@
+package perf
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/protocol/profiler"
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// StartCoverage starts collecting JavaScript coverage of a page.
+// Block coverage is collected only if detailed is true, otherwise coverage is reported per function.
+func (m *Manager) StartCoverage(ctx context.Context, detailed bool) error {
+	m.logger.Trace().Bool("detailed", detailed).Msg("starting to collect coverage")
+
+	if err := m.client.Profiler.Enable(ctx); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to enable profiler")
+
+		return errors.Wrap(err, "enable profiler")
+	}
+
+	args := profiler.NewStartPreciseCoverageArgs().
+		SetCallCount(true).
+		SetDetailed(detailed)
+
+	if _, err := m.client.Profiler.StartPreciseCoverage(ctx, args); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to start coverage")
+
+		m.client.Profiler.Disable(ctx)
+
+		return errors.Wrap(err, "start coverage")
+	}
+
+	m.logger.Trace().Msg("succeeded to start collecting coverage")
+
+	return nil
+}
+
+// StopCoverage stops collecting JavaScript coverage and returns coverage of scripts loaded by a page.
+// Scripts without url, like ones evaluated by drivers, are skipped.
+func (m *Manager) StopCoverage(ctx context.Context) (*values.Array, error) {
+	m.logger.Trace().Msg("starting to take coverage")
+
+	repl, err := m.client.Profiler.TakePreciseCoverage(ctx)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to take coverage")
+
+		return nil, errors.Wrap(err, "take coverage")
+	}
+
+	if err := m.client.Profiler.StopPreciseCoverage(ctx); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to stop coverage")
+
+		return nil, errors.Wrap(err, "stop coverage")
+	}
+
+	if err := m.client.Profiler.Disable(ctx); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to disable profiler")
+	}
+
+	scripts := make([]profiler.ScriptCoverage, 0, len(repl.Result))
+
+	for _, script := range repl.Result {
+		if script.URL != "" {
+			scripts = append(scripts, script)
+		}
+	}
+
+	out, err := json.Marshal(scripts)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal coverage")
+	}
+
+	val, err := values.Unmarshal(out)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal coverage")
+	}
+
+	arr, ok := val.(*values.Array)
+
+	if !ok {
+		return nil, core.TypeError(val.Type(), types.Array)
+	}
+
+	m.logger.Trace().Int("scripts", len(scripts)).Msg("succeeded to take coverage")
+
+	return arr, nil
+}
@@ -0,0 +1,120 @@
+package perf
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/performance"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/eval"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/events"
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/templates"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	rtEvents "github.com/MontFerret/ferret/pkg/runtime/events"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// Manager collects performance metrics of a page.
+// Runtime metrics are provided by the browser, while navigation timing, Web Vitals
+// and resource timings are read from the Performance Timeline of a document.
+type Manager struct {
+	logger zerolog.Logger
+	client *cdp.Client
+}
+
+func New(logger zerolog.Logger, client *cdp.Client) *Manager {
+	m := new(Manager)
+	m.logger = logging.WithName(logger.With(), "perf_manager").Logger()
+	m.client = client
+
+	return m
+}
+
+// GetMetrics returns an object with the following properties:
+// metrics - runtime metrics reported by the browser (e.g. Nodes, JSHeapUsedSize, LayoutDuration),
+// navigation - navigation timing of a document,
+// vitals - Web Vitals (fcp, lcp, cls, fid and ttfb), not yet observed values are None,
+// resources - resource timings of a document.
+func (m *Manager) GetMetrics(ctx context.Context, rt *eval.Runtime) (*values.Object, error) {
+	m.logger.Trace().Msg("starting to get metrics")
+
+	repl, err := m.client.Performance.GetMetrics(ctx)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to get metrics")
+
+		return nil, errors.Wrap(err, "get metrics")
+	}
+
+	out, err := rt.EvalValue(ctx, templates.GetTimings())
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to get timings")
+
+		return nil, errors.Wrap(err, "get timings")
+	}
+
+	timings, ok := out.(*values.Object)
+
+	if !ok {
+		return nil, core.TypeError(out.Type(), types.Object)
+	}
+
+	res := values.NewObjectWith(
+		values.NewObjectProperty("metrics", toObject(repl.Metrics)),
+	)
+
+	timings.ForEach(func(value core.Value, key string) bool {
+		res.Set(values.NewString(key), value)
+
+		return true
+	})
+
+	m.logger.Trace().Msg("succeeded to get metrics")
+
+	return res, nil
+}
+
+// OnMetrics returns a stream of runtime metrics,
+// which are emitted every time a page calls console.timeStamp.
+func (m *Manager) OnMetrics(ctx context.Context) (rtEvents.Stream, error) {
+	m.logger.Trace().Msg("starting to stream metrics events")
+
+	stream, err := m.client.Performance.Metrics(ctx)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to open metrics event stream")
+
+		return nil, err
+	}
+
+	return events.NewEventStream(stream, func(_ context.Context, stream rpcc.Stream) (core.Value, error) {
+		repl, err := stream.(performance.MetricsClient).Recv()
+
+		if err != nil {
+			m.logger.Trace().Err(err).Msg("failed to read data from metrics event stream")
+
+			return values.None, nil
+		}
+
+		return values.NewObjectWith(
+			values.NewObjectProperty("title", values.NewString(repl.Title)),
+			values.NewObjectProperty("metrics", toObject(repl.Metrics)),
+		), nil
+	}), nil
+}
+
+func toObject(metrics []performance.Metric) *values.Object {
+	obj := values.NewObject()
+
+	for _, metric := range metrics {
+		obj.Set(values.NewString(metric.Name), values.NewFloat(metric.Value))
+	}
+
+	return obj
+}
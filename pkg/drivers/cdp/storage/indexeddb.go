@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/indexeddb"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+const indexedDBPageSize = 100
+
+// GetIndexedDBNames returns names of IndexedDB databases of the page URL origin.
+func (m *Manager) GetIndexedDBNames(ctx context.Context, pageURL string) (*values.Array, error) {
+	origin, err := toOrigin(pageURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Trace().
+		Str("origin", origin).
+		Msg("starting to get indexeddb database names")
+
+	repl, err := m.client.IndexedDB.RequestDatabaseNames(ctx, indexeddb.NewRequestDatabaseNamesArgs(origin))
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to get indexeddb database names")
+
+		return nil, errors.Wrap(err, "failed to get indexeddb database names")
+	}
+
+	res := values.NewArray(len(repl.DatabaseNames))
+
+	for _, name := range repl.DatabaseNames {
+		res.Push(values.NewString(name))
+	}
+
+	m.logger.Trace().Msg("succeeded to get indexeddb database names")
+
+	return res, nil
+}
+
+// GetIndexedDBItems returns all entries of a given object store of an IndexedDB database of the page URL origin.
+// Each entry is an object with "key", "primaryKey" and "value" fields.
+func (m *Manager) GetIndexedDBItems(ctx context.Context, pageURL, database, store string) (*values.Array, error) {
+	origin, err := toOrigin(pageURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	logger := m.logger.With().
+		Str("origin", origin).
+		Str("database", database).
+		Str("store", store).
+		Logger()
+
+	logger.Trace().Msg("starting to get indexeddb items")
+
+	res := values.NewArray(indexedDBPageSize)
+
+	for {
+		repl, err := m.client.IndexedDB.RequestData(
+			ctx,
+			indexeddb.NewRequestDataArgs(origin, database, store, "", int(res.Length()), indexedDBPageSize),
+		)
+
+		if err != nil {
+			logger.Trace().Err(err).Msg("failed to get indexeddb items")
+
+			return nil, errors.Wrap(err, "failed to get indexeddb items")
+		}
+
+		for _, entry := range repl.ObjectStoreDataEntries {
+			item := values.NewObject()
+
+			for name, ref := range map[string]runtime.RemoteObject{
+				"key":        entry.Key,
+				"primaryKey": entry.PrimaryKey,
+				"value":      entry.Value,
+			} {
+				value, err := m.toValue(ctx, ref)
+
+				if err != nil {
+					logger.Trace().Err(err).Msg("failed to read indexeddb item")
+
+					return nil, errors.Wrap(err, "failed to read indexeddb item")
+				}
+
+				item.Set(values.NewString(name), value)
+			}
+
+			res.Push(item)
+		}
+
+		if !repl.HasMore || len(repl.ObjectStoreDataEntries) == 0 {
+			break
+		}
+	}
+
+	logger.Trace().Int("count", int(res.Length())).Msg("succeeded to get indexeddb items")
+
+	return res, nil
+}
+
+// toValue copies a value of a remote object and releases the object.
+func (m *Manager) toValue(ctx context.Context, ref runtime.RemoteObject) (core.Value, error) {
+	if ref.ObjectID == nil {
+		if ref.Value == nil {
+			return values.None, nil
+		}
+
+		return values.Unmarshal(ref.Value)
+	}
+
+	defer func() {
+		if err := m.client.Runtime.ReleaseObject(ctx, runtime.NewReleaseObjectArgs(*ref.ObjectID)); err != nil {
+			m.logger.Trace().Err(err).Msg("failed to release a remote object")
+		}
+	}()
+
+	repl, err := m.client.Runtime.CallFunctionOn(
+		ctx,
+		runtime.NewCallFunctionOnArgs("function() { return this; }").
+			SetObjectID(*ref.ObjectID).
+			SetReturnByValue(true),
+	)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	if repl.ExceptionDetails != nil {
+		return values.None, errors.New(repl.ExceptionDetails.Text)
+	}
+
+	if repl.Result.Value == nil {
+		return values.None, nil
+	}
+
+	return values.Unmarshal(repl.Result.Value)
+}
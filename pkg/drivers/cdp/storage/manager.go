@@ -0,0 +1,242 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/domstorage"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Manager provides access to web storages (localStorage and sessionStorage) of a page.
+// Storages are addressed by an origin of a given URL.
+// IndexedDB databases are read-only, since the DevTools protocol does not allow to write to them.
+type Manager struct {
+	logger zerolog.Logger
+	client *cdp.Client
+}
+
+func New(logger zerolog.Logger, client *cdp.Client) *Manager {
+	m := new(Manager)
+	m.logger = logging.WithName(logger.With(), "storage_manager").Logger()
+	m.client = client
+
+	return m
+}
+
+func (m *Manager) GetItems(ctx context.Context, pageURL string, storageType drivers.StorageType) (*values.Object, error) {
+	id, err := toStorageID(pageURL, storageType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Trace().
+		Str("origin", *id.SecurityOrigin).
+		Str("type", string(storageType)).
+		Msg("starting to get storage items")
+
+	repl, err := m.client.DOMStorage.GetDOMStorageItems(ctx, domstorage.NewGetDOMStorageItemsArgs(id))
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to get storage items")
+
+		return nil, errors.Wrap(err, "failed to get storage items")
+	}
+
+	res := values.NewObject()
+
+	for _, item := range repl.Entries {
+		if len(item) < 2 {
+			continue
+		}
+
+		res.Set(values.NewString(item[0]), values.NewString(item[1]))
+	}
+
+	m.logger.Trace().Msg("succeeded to get storage items")
+
+	return res, nil
+}
+
+func (m *Manager) SetItems(ctx context.Context, pageURL string, storageType drivers.StorageType, items map[string]string) error {
+	id, err := toStorageID(pageURL, storageType)
+
+	if err != nil {
+		return err
+	}
+
+	m.logger.Trace().
+		Str("origin", *id.SecurityOrigin).
+		Str("type", string(storageType)).
+		Int("count", len(items)).
+		Msg("starting to set storage items")
+
+	for key, value := range items {
+		err := m.client.DOMStorage.SetDOMStorageItem(ctx, domstorage.NewSetDOMStorageItemArgs(id, key, value))
+
+		if err != nil {
+			m.logger.Trace().Err(err).Str("key", key).Msg("failed to set storage item")
+
+			return errors.Wrap(err, "failed to set storage item")
+		}
+	}
+
+	m.logger.Trace().Msg("succeeded to set storage items")
+
+	return nil
+}
+
+func (m *Manager) Clear(ctx context.Context, pageURL string, storageType drivers.StorageType) error {
+	id, err := toStorageID(pageURL, storageType)
+
+	if err != nil {
+		return err
+	}
+
+	m.logger.Trace().
+		Str("origin", *id.SecurityOrigin).
+		Str("type", string(storageType)).
+		Msg("starting to clear storage")
+
+	if err := m.client.DOMStorage.Clear(ctx, domstorage.NewClearArgs(id)); err != nil {
+		m.logger.Trace().Err(err).Msg("failed to clear storage")
+
+		return errors.Wrap(err, "failed to clear storage")
+	}
+
+	m.logger.Trace().Msg("succeeded to clear storage")
+
+	return nil
+}
+
+// preloadScript sets storage items in a top-level document of a given origin.
+// Storages of documents with an opaque origin are not accessible, thus errors are ignored.
+const preloadScript = `(() => {
+	if (window.top !== window || window.location.origin !== %s) {
+		return;
+	}
+
+	const items = %s;
+
+	try {
+		Object.keys(items.local).forEach((key) => window.localStorage.setItem(key, items.local[key]));
+		Object.keys(items.session).forEach((key) => window.sessionStorage.setItem(key, items.session[key]));
+	} catch (e) {}
+})()`
+
+// Preload registers a script that sets given items once a new document of the page URL origin is created,
+// that is, before any script of the page runs.
+// The script must be removed via RemovePreload after the first navigation,
+// otherwise it overrides changes made by the page on every next one.
+func (m *Manager) Preload(ctx context.Context, pageURL string, items drivers.Storage) (page.ScriptIdentifier, error) {
+	id, err := toStorageID(pageURL, drivers.StorageTypeLocal)
+
+	if err != nil {
+		return "", err
+	}
+
+	origin, err := json.Marshal(*id.SecurityOrigin)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(map[string]map[string]string{
+		"local":   toItems(items.Local),
+		"session": toItems(items.Session),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	m.logger.Trace().
+		Str("origin", *id.SecurityOrigin).
+		Int("local", len(items.Local)).
+		Int("session", len(items.Session)).
+		Msg("starting to preload storage items")
+
+	repl, err := m.client.Page.AddScriptToEvaluateOnNewDocument(
+		ctx,
+		page.NewAddScriptToEvaluateOnNewDocumentArgs(fmt.Sprintf(preloadScript, origin, data)),
+	)
+
+	if err != nil {
+		m.logger.Trace().Err(err).Msg("failed to preload storage items")
+
+		return "", errors.Wrap(err, "failed to preload storage items")
+	}
+
+	m.logger.Trace().Msg("succeeded to preload storage items")
+
+	return repl.Identifier, nil
+}
+
+// RemovePreload removes a script registered by Preload.
+func (m *Manager) RemovePreload(ctx context.Context, id page.ScriptIdentifier) error {
+	err := m.client.Page.RemoveScriptToEvaluateOnNewDocument(ctx, page.NewRemoveScriptToEvaluateOnNewDocumentArgs(id))
+
+	if err != nil {
+		return errors.Wrap(err, "failed to remove storage preload script")
+	}
+
+	return nil
+}
+
+func toItems(items map[string]string) map[string]string {
+	if items == nil {
+		return map[string]string{}
+	}
+
+	return items
+}
+
+func toStorageID(pageURL string, storageType drivers.StorageType) (domstorage.StorageID, error) {
+	if !drivers.IsStorageTypeValid(string(storageType)) {
+		return domstorage.StorageID{}, core.Errorf(core.ErrInvalidArgument, "storage type: %s", storageType)
+	}
+
+	origin, err := toOrigin(pageURL)
+
+	if err != nil {
+		return domstorage.StorageID{}, err
+	}
+
+	return domstorage.StorageID{
+		SecurityOrigin: &origin,
+		IsLocalStorage: storageType == drivers.StorageTypeLocal,
+	}, nil
+}
+
+func toOrigin(pageURL string) (string, error) {
+	u, err := url.Parse(pageURL)
+
+	if err != nil {
+		return "", errors.Wrap(err, "parse page url")
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return "", errors.Wrapf(core.ErrInvalidOperation, "page has no storage origin: %s", pageURL)
+	}
+
+	host := strings.ToLower(u.Host)
+
+	// an origin omits a default port
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		host = strings.ToLower(u.Hostname())
+	}
+
+	return strings.ToLower(u.Scheme) + "://" + host, nil
+}
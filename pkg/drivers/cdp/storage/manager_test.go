@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+func TestToStorageID(t *testing.T) {
+	Convey("Should use an origin of a page URL", t, func() {
+		id, err := toStorageID("http://127.0.0.1:8080/foo?bar=baz", drivers.StorageTypeLocal)
+
+		So(err, ShouldBeNil)
+		So(*id.SecurityOrigin, ShouldEqual, "http://127.0.0.1:8080")
+		So(id.IsLocalStorage, ShouldBeTrue)
+	})
+
+	Convey("Should omit a default port", t, func() {
+		id, err := toStorageID("HTTPS://Example.com:443/", drivers.StorageTypeSession)
+
+		So(err, ShouldBeNil)
+		So(*id.SecurityOrigin, ShouldEqual, "https://example.com")
+		So(id.IsLocalStorage, ShouldBeFalse)
+	})
+
+	Convey("Should fail for a URL without an origin", t, func() {
+		_, err := toStorageID("about:blank", drivers.StorageTypeLocal)
+
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,307 @@
+package cdp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/rs/zerolog"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/events"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+const pageEventBufferSize = 10
+
+type (
+	// pageLoader connects to a given browser target and loads it as a page.
+	pageLoader func(ctx context.Context, id target.ID) (*HTMLPage, error)
+
+	// targetManager tracks pages (tabs and popups) opened by a page with a given target id.
+	targetManager struct {
+		mu        sync.Mutex
+		logger    zerolog.Logger
+		id        target.ID
+		load      pageLoader
+		cancel    context.CancelFunc
+		pages     []*HTMLPage
+		loading   map[target.ID]bool
+		listeners map[*pageEventStream]struct{}
+		closed    bool
+	}
+
+	pageEventStream struct {
+		messages chan events.Message
+		remove   func(stream *pageEventStream)
+	}
+)
+
+func newTargetManager(
+	logger zerolog.Logger,
+	client *cdp.Client,
+	id target.ID,
+	load pageLoader,
+) (*targetManager, error) {
+	m := new(targetManager)
+	m.logger = logging.WithName(logger.With(), "cdp_target_manager").Str("target_id", string(id)).Logger()
+	m.id = id
+	m.load = load
+	m.pages = make([]*HTMLPage, 0, 2)
+	m.loading = make(map[target.ID]bool)
+	m.listeners = make(map[*pageEventStream]struct{})
+
+	ctx, cancel := context.WithCancel(logger.WithContext(context.Background()))
+	m.cancel = cancel
+
+	onCreated, err := client.Target.TargetCreated(ctx)
+
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	onDestroyed, err := client.Target.TargetDestroyed(ctx)
+
+	if err != nil {
+		onCreated.Close()
+		cancel()
+
+		return nil, err
+	}
+
+	if err := rpcc.Sync(onCreated, onDestroyed); err != nil {
+		onCreated.Close()
+		onDestroyed.Close()
+		cancel()
+
+		return nil, err
+	}
+
+	go m.run(ctx, onCreated, onDestroyed)
+
+	return m, nil
+}
+
+// Pages returns all pages opened by the target, including pages opened by them.
+func (m *targetManager) Pages() []*HTMLPage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*HTMLPage, 0, len(m.pages))
+
+	for _, p := range m.pages {
+		result = append(result, p)
+
+		if targets := p.getTargets(); targets != nil {
+			result = append(result, targets.Pages()...)
+		}
+	}
+
+	return result
+}
+
+// OnPage returns a stream of pages opened by the target.
+func (m *targetManager) OnPage() (events.Stream, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, core.Error(core.ErrInvalidOperation, "page is closed")
+	}
+
+	stream := &pageEventStream{
+		messages: make(chan events.Message, pageEventBufferSize),
+		remove:   m.removeListener,
+	}
+
+	m.listeners[stream] = struct{}{}
+
+	return stream, nil
+}
+
+func (m *targetManager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil
+	}
+
+	m.closed = true
+	m.cancel()
+
+	for stream := range m.listeners {
+		close(stream.messages)
+	}
+
+	m.listeners = nil
+
+	for _, p := range m.pages {
+		if err := p.Close(); err != nil {
+			m.logger.Warn().Err(err).Msg("failed to close a child page")
+		}
+	}
+
+	m.pages = nil
+
+	return nil
+}
+
+func (m *targetManager) run(ctx context.Context, onCreated target.CreatedClient, onDestroyed target.DestroyedClient) {
+	defer func() {
+		onCreated.Close()
+		onDestroyed.Close()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-onCreated.Ready():
+			repl, err := onCreated.Recv()
+
+			if err != nil {
+				m.logger.Trace().Err(err).Msg("failed to read target created event")
+
+				return
+			}
+
+			info := repl.TargetInfo
+
+			if info.Type != "page" || info.OpenerID == nil || *info.OpenerID != m.id {
+				continue
+			}
+
+			m.mu.Lock()
+			m.loading[info.TargetID] = false
+			m.mu.Unlock()
+
+			// pages get loaded in the background, so that destroyed targets keep being handled meanwhile
+			go m.handleCreated(ctx, info.TargetID)
+		case <-onDestroyed.Ready():
+			repl, err := onDestroyed.Recv()
+
+			if err != nil {
+				m.logger.Trace().Err(err).Msg("failed to read target destroyed event")
+
+				return
+			}
+
+			m.handleDestroyed(repl.TargetID)
+		}
+	}
+}
+
+func (m *targetManager) handleCreated(ctx context.Context, id target.ID) {
+	m.logger.Trace().Str("child_id", string(id)).Msg("child page opened")
+
+	loadCtx, cancel := context.WithTimeout(ctx, time.Duration(drivers.DefaultPageLoadTimeout)*time.Millisecond)
+	defer cancel()
+
+	p, err := m.load(loadCtx, id)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	destroyed := m.loading[id]
+	delete(m.loading, id)
+
+	if err != nil {
+		m.logger.Error().Err(err).Str("child_id", string(id)).Msg("failed to load a child page")
+
+		return
+	}
+
+	if m.closed || destroyed {
+		if err := p.Close(); err != nil {
+			m.logger.Warn().Err(err).Msg("failed to close a child page")
+		}
+
+		return
+	}
+
+	m.pages = append(m.pages, p)
+
+	for stream := range m.listeners {
+		select {
+		case stream.messages <- events.WithValue(p):
+		default:
+			m.logger.Warn().Str("child_id", string(id)).Msg("page event listener is not ready, skipping the event")
+		}
+	}
+}
+
+func (m *targetManager) handleDestroyed(id target.ID) {
+	m.mu.Lock()
+
+	// a page that is still being loaded gets closed once loaded
+	if _, exists := m.loading[id]; exists {
+		m.loading[id] = true
+		m.mu.Unlock()
+
+		return
+	}
+
+	var found *HTMLPage
+
+	for i, p := range m.pages {
+		if targets := p.getTargets(); targets != nil && targets.id == id {
+			found = p
+			m.pages = append(m.pages[:i], m.pages[i+1:]...)
+
+			break
+		}
+	}
+
+	m.mu.Unlock()
+
+	if found == nil {
+		return
+	}
+
+	m.logger.Trace().Str("child_id", string(id)).Msg("child page closed")
+
+	if err := found.Close(); err != nil {
+		m.logger.Warn().Err(err).Msg("failed to close a child page")
+	}
+}
+
+func (m *targetManager) removeListener(stream *pageEventStream) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.listeners[stream]; !exists {
+		return
+	}
+
+	delete(m.listeners, stream)
+	close(stream.messages)
+}
+
+func (s *pageEventStream) Close(_ context.Context) error {
+	s.remove(s)
+
+	return nil
+}
+
+func (s *pageEventStream) Read(_ context.Context) <-chan events.Message {
+	return s.messages
+}
+
+func toPageArray(root *HTMLPage, pages []*HTMLPage) *values.Array {
+	arr := values.NewArray(len(pages) + 1)
+	arr.Push(root)
+
+	for _, p := range pages {
+		arr.Push(p)
+	}
+
+	return arr
+}
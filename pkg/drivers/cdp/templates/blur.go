@@ -39,13 +39,7 @@ var (
 )
 
 func BlurBySelector(id runtime.RemoteObjectID, selector drivers.QuerySelector) *eval.Function {
-	var f *eval.Function
-
-	if selector.Kind() == drivers.CSSSelector {
-		f = eval.F(blurByCSSSelector)
-	} else {
-		f = eval.F(blurByXPathSelector)
-	}
-
-	return f.WithArgRef(id).WithArgSelector(selector)
+	return toFunction(selector, blurByCSSSelector, blurByXPathSelector).
+		WithArgRef(id).
+		WithArgSelector(selector)
 }
@@ -0,0 +1,29 @@
+package templates
+
+import (
+	"github.com/mafredri/cdp/protocol/runtime"
+
+	"github.com/MontFerret/ferret/pkg/drivers/cdp/eval"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// Eval returns a user defined function.
+// Elements given as arguments are passed by reference, other values are passed by value.
+// If an element id is given, the element is passed as the first argument.
+func Eval(expression string, id runtime.RemoteObjectID, args []core.Value) *eval.Function {
+	f := eval.F(expression).AsAsync()
+
+	if id != eval.EmptyObjectID {
+		f.WithArgRef(id)
+	}
+
+	for _, arg := range args {
+		if remote, ok := arg.(eval.RemoteValue); ok {
+			f.WithArgRemoteValue(remote)
+		} else {
+			f.WithArgValue(arg)
+		}
+	}
+
+	return f
+}
@@ -23,9 +23,24 @@ func EscapeString(value string) string {
 }
 
 func toFunction(selector drivers.QuerySelector, cssTmpl, xPathTmpl string) *eval.Function {
-	if selector.Kind() == drivers.CSSSelector {
-		return eval.F(cssTmpl)
-	}
+	return eval.F(toTemplate(selector, cssTmpl, xPathTmpl))
+}
 
-	return eval.F(xPathTmpl)
+func toTemplate(selector drivers.QuerySelector, cssTmpl, xPathTmpl string) string {
+	switch selector.Kind() {
+	case drivers.CSSSelector:
+		return cssTmpl
+	case drivers.ShadowSelector:
+		return toQueryProxyTemplate(cssTmpl, shadowQueryFragment)
+	case drivers.TextSelector:
+		return toQueryProxyTemplate(cssTmpl, textQueryFragment)
+	case drivers.RoleSelector:
+		return toQueryProxyTemplate(cssTmpl, roleQueryFragment)
+	case drivers.LabelSelector:
+		return toQueryProxyTemplate(cssTmpl, labelQueryFragment)
+	case drivers.PlaceholderSelector:
+		return toQueryProxyTemplate(cssTmpl, placeholderQueryFragment)
+	default:
+		return xPathTmpl
+	}
 }
@@ -0,0 +1,256 @@
+package templates
+
+import "fmt"
+
+// matchTextFragment declares a function that matches text against an expected value.
+// Quoted values must match exactly, other values are matched as case-insensitive substrings.
+// Whitespaces are normalized in both cases.
+const matchTextFragment = `
+	const normalizeText = (str) => (str || '').replace(/\s+/g, ' ').trim();
+
+	const matchText = (actual, expected) => {
+		const value = normalizeText(actual);
+
+		if (expected.length > 1 && expected.startsWith('"') && expected.endsWith('"')) {
+			return value === normalizeText(expected.slice(1, -1));
+		}
+
+		return value.toLowerCase().includes(normalizeText(expected).toLowerCase());
+	};
+
+	const inDocumentOrder = (elements) => Array.from(new Set(elements)).sort((a, b) => {
+		return a.compareDocumentPosition(b) & Node.DOCUMENT_POSITION_FOLLOWING ? -1 : 1;
+	});
+`
+
+var textQueryFragment = fmt.Sprintf(`
+	%s
+
+	const ignoredTags = ['SCRIPT', 'STYLE', 'NOSCRIPT', 'TEMPLATE', 'HEAD'];
+	const buttonTypes = ['button', 'submit', 'reset'];
+
+	const isVisible = (el) => el.getClientRects().length > 0;
+
+	const textOf = (el) => {
+		if (el instanceof HTMLInputElement && buttonTypes.includes(el.type)) {
+			return el.value;
+		}
+
+		return el.innerText;
+	};
+
+	const isMatched = (el, selector) => {
+		return !ignoredTags.includes(el.tagName) && isVisible(el) && matchText(textOf(el), selector);
+	};
+
+	const queryAll = (root, selector) => {
+		return Array.from(root.querySelectorAll('*')).filter((el) => {
+			// only the innermost elements containing the text
+			return isMatched(el, selector) && !Array.from(el.children).some((child) => isMatched(child, selector));
+		});
+	};
+`, matchTextFragment)
+
+var roleQueryFragment = fmt.Sprintf(`
+	%s
+
+	const nameFromContent = [
+		'button', 'cell', 'checkbox', 'columnheader', 'heading', 'link', 'listitem',
+		'menuitem', 'option', 'radio', 'row', 'switch', 'tab', 'tooltip', 'treeitem'
+	];
+
+	const roleOf = (el) => {
+		const explicit = (el.getAttribute('role') || '').trim();
+
+		if (explicit !== '') {
+			return explicit.split(/\s+/)[0].toLowerCase();
+		}
+
+		switch (el.tagName.toLowerCase()) {
+		case 'a':
+		case 'area':
+			return el.hasAttribute('href') ? 'link' : '';
+		case 'article':
+			return 'article';
+		case 'aside':
+			return 'complementary';
+		case 'button':
+			return 'button';
+		case 'dialog':
+			return 'dialog';
+		case 'footer':
+			return 'contentinfo';
+		case 'form':
+			return 'form';
+		case 'h1':
+		case 'h2':
+		case 'h3':
+		case 'h4':
+		case 'h5':
+		case 'h6':
+			return 'heading';
+		case 'header':
+			return 'banner';
+		case 'hr':
+			return 'separator';
+		case 'img':
+			return el.getAttribute('alt') === '' ? 'presentation' : 'img';
+		case 'input': {
+			const type = (el.getAttribute('type') || 'text').toLowerCase();
+
+			switch (type) {
+			case 'button':
+			case 'image':
+			case 'reset':
+			case 'submit':
+				return 'button';
+			case 'checkbox':
+				return 'checkbox';
+			case 'radio':
+				return 'radio';
+			case 'range':
+				return 'slider';
+			case 'number':
+				return 'spinbutton';
+			case 'search':
+				return 'searchbox';
+			case 'hidden':
+			case 'file':
+			case 'color':
+				return '';
+			default:
+				return 'textbox';
+			}
+		}
+		case 'li':
+			return 'listitem';
+		case 'main':
+			return 'main';
+		case 'nav':
+			return 'navigation';
+		case 'ol':
+		case 'ul':
+			return 'list';
+		case 'option':
+			return 'option';
+		case 'p':
+			return 'paragraph';
+		case 'progress':
+			return 'progressbar';
+		case 'select':
+			return el.multiple || el.size > 1 ? 'listbox' : 'combobox';
+		case 'table':
+			return 'table';
+		case 'td':
+			return 'cell';
+		case 'textarea':
+			return 'textbox';
+		case 'th':
+			return 'columnheader';
+		case 'tr':
+			return 'row';
+		default:
+			return '';
+		}
+	};
+
+	const nameOf = (el, role) => {
+		const labelledBy = (el.getAttribute('aria-labelledby') || '').trim();
+
+		if (labelledBy !== '') {
+			return labelledBy.split(/\s+/).map((id) => {
+				const ref = el.ownerDocument.getElementById(id);
+
+				return ref != null ? ref.textContent : '';
+			}).join(' ');
+		}
+
+		const label = el.getAttribute('aria-label');
+
+		if (label) {
+			return label;
+		}
+
+		if (el.labels != null && el.labels.length > 0) {
+			return Array.from(el.labels).map((item) => item.textContent).join(' ');
+		}
+
+		if (el instanceof HTMLInputElement && ['button', 'submit', 'reset'].includes(el.type)) {
+			return el.value;
+		}
+
+		if (el instanceof HTMLImageElement || (el instanceof HTMLInputElement && el.type === 'image')) {
+			return el.getAttribute('alt') || '';
+		}
+
+		if (nameFromContent.includes(role)) {
+			return el.textContent;
+		}
+
+		return el.getAttribute('title') || el.getAttribute('placeholder') || '';
+	};
+
+	const queryAll = (root, selector) => {
+		const parsed = selector.match(/^\s*([\w-]+)\s*(?:\[\s*name\s*=\s*(.*?)\s*\])?\s*$/);
+
+		if (parsed == null) {
+			return [];
+		}
+
+		const [, expectedRole, expectedName] = parsed;
+
+		return Array.from(root.querySelectorAll('*')).filter((el) => {
+			const role = roleOf(el);
+
+			if (role !== expectedRole.toLowerCase()) {
+				return false;
+			}
+
+			return expectedName == null || matchText(nameOf(el, role), expectedName);
+		});
+	};
+`, matchTextFragment)
+
+var labelQueryFragment = fmt.Sprintf(`
+	%s
+
+	const queryAll = (root, selector) => {
+		const found = [];
+
+		root.querySelectorAll('label').forEach((label) => {
+			if (label.control != null && matchText(label.textContent, selector)) {
+				found.push(label.control);
+			}
+		});
+
+		root.querySelectorAll('[aria-label]').forEach((el) => {
+			if (matchText(el.getAttribute('aria-label'), selector)) {
+				found.push(el);
+			}
+		});
+
+		root.querySelectorAll('[aria-labelledby]').forEach((el) => {
+			const text = el.getAttribute('aria-labelledby').trim().split(/\s+/).map((id) => {
+				const ref = el.ownerDocument.getElementById(id);
+
+				return ref != null ? ref.textContent : '';
+			}).join(' ');
+
+			if (matchText(text, selector)) {
+				found.push(el);
+			}
+		});
+
+		return inDocumentOrder(found);
+	};
+`, matchTextFragment)
+
+var placeholderQueryFragment = fmt.Sprintf(`
+	%s
+
+	const queryAll = (root, selector) => {
+		return Array.from(root.querySelectorAll('[placeholder]')).filter((el) => {
+			return matchText(el.getAttribute('placeholder'), selector);
+		});
+	};
+`, matchTextFragment)
@@ -0,0 +1,87 @@
+package templates
+
+import "github.com/MontFerret/ferret/pkg/drivers/cdp/eval"
+
+// Buffered entries get copied into an observer buffer synchronously,
+// therefore they can be taken right after the observation has started.
+const getTimings = `() => {
+	const takeEntries = (type) => {
+		try {
+			const observer = new PerformanceObserver(() => {});
+			observer.observe({ type, buffered: true });
+			const entries = observer.takeRecords();
+			observer.disconnect();
+
+			return entries;
+		} catch (e) {
+			return [];
+		}
+	};
+
+	const vitals = {
+		fcp: null,
+		lcp: null,
+		cls: null,
+		fid: null,
+		ttfb: null
+	};
+
+	const [navigation] = performance.getEntriesByType('navigation');
+
+	if (navigation != null) {
+		vitals.ttfb = navigation.responseStart;
+	}
+
+	const fcp = takeEntries('paint').find((entry) => entry.name === 'first-contentful-paint');
+
+	if (fcp != null) {
+		vitals.fcp = fcp.startTime;
+	}
+
+	const lcp = takeEntries('largest-contentful-paint').pop();
+
+	if (lcp != null) {
+		vitals.lcp = lcp.renderTime || lcp.loadTime || lcp.startTime;
+	}
+
+	const shifts = takeEntries('layout-shift').filter((entry) => !entry.hadRecentInput);
+
+	if (shifts.length > 0) {
+		// the largest burst of shifts, which are less than 1s apart and fit into a 5s window
+		let current = 0;
+		let largest = 0;
+		let first = null;
+		let last = null;
+
+		shifts.forEach((entry) => {
+			if (last != null && entry.startTime - last.startTime < 1000 && entry.startTime - first.startTime < 5000) {
+				current += entry.value;
+			} else {
+				current = entry.value;
+				first = entry;
+			}
+
+			last = entry;
+			largest = Math.max(largest, current);
+		});
+
+		vitals.cls = largest;
+	}
+
+	const [input] = takeEntries('first-input');
+
+	if (input != null) {
+		vitals.fid = input.processingStart - input.startTime;
+	}
+
+	return {
+		navigation: navigation != null ? navigation.toJSON() : null,
+		vitals,
+		resources: performance.getEntriesByType('resource').map((entry) => entry.toJSON())
+	};
+}`
+
+// GetTimings returns navigation timing, Web Vitals and resource timings of a document.
+func GetTimings() *eval.Function {
+	return eval.F(getTimings)
+}
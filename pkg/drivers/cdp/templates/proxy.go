@@ -0,0 +1,30 @@
+package templates
+
+import "fmt"
+
+// toQueryProxyTemplate turns a CSS selector based template into one, that finds elements with a custom query.
+// The query fragment must declare a "queryAll(root, selector)" function that returns an array of found elements.
+// The template must accept the target element as its first argument.
+func toQueryProxyTemplate(cssTmpl, queryFragment string) string {
+	return fmt.Sprintf(`(el, ...args) => {
+	%s
+
+	const target = new Proxy(el, {
+		get(target, prop) {
+			if (prop === 'querySelector') {
+				return (selector) => queryAll(target, selector)[0] || null;
+			}
+
+			if (prop === 'querySelectorAll') {
+				return (selector) => queryAll(target, selector);
+			}
+
+			const value = Reflect.get(target, prop);
+
+			return typeof value === 'function' ? value.bind(target) : value;
+		}
+	});
+
+	return (%s)(target, ...args);
+}`, queryFragment, cssTmpl)
+}
@@ -0,0 +1,31 @@
+package templates
+
+// shadowQueryFragment finds elements piercing open shadow roots.
+// Parts of a selector separated by ">>>" are matched one inside another.
+const shadowQueryFragment = `
+	const queryAll = (root, selector) => {
+		const collect = (scope, css, out) => {
+			out.push(...scope.querySelectorAll(css));
+
+			scope.querySelectorAll('*').forEach((child) => {
+				if (child.shadowRoot != null) {
+					collect(child.shadowRoot, css, out);
+				}
+			});
+		};
+
+		let scopes = root.shadowRoot != null ? [root, root.shadowRoot] : [root];
+		let found = [];
+
+		selector.split('>>>').map((part) => part.trim()).filter((part) => part !== '').forEach((part) => {
+			const matched = [];
+
+			scopes.forEach((scope) => collect(scope, part, matched));
+
+			found = Array.from(new Set(matched));
+			scopes = found.map((item) => item.shadowRoot != null ? item.shadowRoot : item);
+		});
+
+		return found;
+	};
+`
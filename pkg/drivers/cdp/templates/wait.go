@@ -179,13 +179,11 @@ func partialWaitEquality(id runtime.RemoteObjectID, expected core.Value, when dr
 }
 
 func partialWaitExistenceBySelector(id runtime.RemoteObjectID, selector drivers.QuerySelector, when drivers.WaitEvent, fragment string) *eval.Function {
-	var tmpl string
-
-	if selector.Kind() == drivers.CSSSelector {
-		tmpl = fmt.Sprintf(waitExistenceBySelectorFragment, queryCSSSelectorFragment, fragment)
-	} else {
-		tmpl = fmt.Sprintf(waitExistenceBySelectorFragment, xpathAsElementFragment, fragment)
-	}
+	tmpl := toTemplate(
+		selector,
+		fmt.Sprintf(waitExistenceBySelectorFragment, queryCSSSelectorFragment, fragment),
+		fmt.Sprintf(waitExistenceBySelectorFragment, xpathAsElementFragment, fragment),
+	)
 
 	return eval.F(tmpl).
 		WithArgRef(id).
@@ -194,13 +192,11 @@ func partialWaitExistenceBySelector(id runtime.RemoteObjectID, selector drivers.
 }
 
 func partialWaitEqualityBySelector(id runtime.RemoteObjectID, selector drivers.QuerySelector, expected core.Value, when drivers.WaitEvent, fragment string) *eval.Function {
-	var tmpl string
-
-	if selector.Kind() == drivers.CSSSelector {
-		tmpl = fmt.Sprintf(waitEqualityBySelectorFragment, queryCSSSelectorFragment, fragment)
-	} else {
-		tmpl = fmt.Sprintf(waitEqualityBySelectorFragment, xpathAsElementFragment, fragment)
-	}
+	tmpl := toTemplate(
+		selector,
+		fmt.Sprintf(waitEqualityBySelectorFragment, queryCSSSelectorFragment, fragment),
+		fmt.Sprintf(waitEqualityBySelectorFragment, xpathAsElementFragment, fragment),
+	)
 
 	return eval.F(tmpl).
 		WithArgRef(id).
@@ -210,13 +206,11 @@ func partialWaitEqualityBySelector(id runtime.RemoteObjectID, selector drivers.Q
 }
 
 func partialWaitExistenceBySelectorAll(id runtime.RemoteObjectID, selector drivers.QuerySelector, when drivers.WaitEvent, fragment string) *eval.Function {
-	var tmpl string
-
-	if selector.Kind() == drivers.CSSSelector {
-		tmpl = fmt.Sprintf(waitExistenceBySelectorAllFragment, queryCSSSelectorAllFragment, fragment)
-	} else {
-		tmpl = fmt.Sprintf(waitExistenceBySelectorAllFragment, xpathAsElementArrayFragment, fragment)
-	}
+	tmpl := toTemplate(
+		selector,
+		fmt.Sprintf(waitExistenceBySelectorAllFragment, queryCSSSelectorAllFragment, fragment),
+		fmt.Sprintf(waitExistenceBySelectorAllFragment, xpathAsElementArrayFragment, fragment),
+	)
 
 	return eval.F(tmpl).
 		WithArgRef(id).
@@ -225,13 +219,11 @@ func partialWaitExistenceBySelectorAll(id runtime.RemoteObjectID, selector drive
 }
 
 func partialWaitEqualityBySelectorAll(id runtime.RemoteObjectID, selector drivers.QuerySelector, expected core.Value, when drivers.WaitEvent, fragment string) *eval.Function {
-	var tmpl string
-
-	if selector.Kind() == drivers.CSSSelector {
-		tmpl = fmt.Sprintf(waitEqualityBySelectorAllFragment, queryCSSSelectorAllFragment, fragment)
-	} else {
-		tmpl = fmt.Sprintf(waitEqualityBySelectorAllFragment, xpathAsElementArrayFragment, fragment)
-	}
+	tmpl := toTemplate(
+		selector,
+		fmt.Sprintf(waitEqualityBySelectorAllFragment, queryCSSSelectorAllFragment, fragment),
+		fmt.Sprintf(waitEqualityBySelectorAllFragment, xpathAsElementArrayFragment, fragment),
+	)
 
 	return eval.F(tmpl).
 		WithArgRef(id).
@@ -251,15 +243,16 @@ return found;
 })()`, xpathAsElementFragment)
 
 func WaitForElement(id runtime.RemoteObjectID, selector drivers.QuerySelector, when drivers.WaitEvent) *eval.Function {
-	var tmpl string
-
-	if selector.Kind() == drivers.CSSSelector {
-		tmpl = waitForElementByCSSFragment
-	} else {
-		tmpl = waitForElementByXPathFragment
-	}
+	tmpl := toTemplate(
+		selector,
+		fmt.Sprintf(waitExistenceFragment, waitForElementByCSSFragment),
+		fmt.Sprintf(waitExistenceFragment, waitForElementByXPathFragment),
+	)
 
-	return partialWaitExistence(id, when, tmpl).WithArgSelector(selector)
+	return eval.F(tmpl).
+		WithArgRef(id).
+		WithArg(int(when)).
+		WithArgSelector(selector)
 }
 
 const waitForElementAllByCSSFragment = `(function() {
@@ -277,15 +270,17 @@ return found;
 })()`, xpathAsElementArrayFragment)
 
 func WaitForElementAll(id runtime.RemoteObjectID, selector drivers.QuerySelector, when drivers.WaitEvent) *eval.Function {
-	var tmpl string
+	tmpl := toTemplate(
+		selector,
+		fmt.Sprintf(waitEqualityFragment, waitForElementAllByCSSFragment),
+		fmt.Sprintf(waitEqualityFragment, waitForElementAllByXPathFragment),
+	)
 
-	if selector.Kind() == drivers.CSSSelector {
-		tmpl = waitForElementAllByCSSFragment
-	} else {
-		tmpl = waitForElementAllByXPathFragment
-	}
-
-	return partialWaitEquality(id, values.ZeroInt, when, tmpl).WithArgSelector(selector)
+	return eval.F(tmpl).
+		WithArgRef(id).
+		WithArgValue(values.ZeroInt).
+		WithArg(int(when)).
+		WithArgSelector(selector)
 }
 
 const waitForClassFragment = `el.className.split(' ').find(i => i === args[0]);`
@@ -19,3 +19,21 @@ func GetLayoutViewportWH(metrics *page.GetLayoutMetricsReply) (width int, height
 
 	return
 }
+
+func GetVisualViewportPageXY(metrics *page.GetLayoutMetricsReply) (x float64, y float64) {
+	if metrics.CSSVisualViewport.ClientWidth > 0 {
+		return metrics.CSSVisualViewport.PageX, metrics.CSSVisualViewport.PageY
+	}
+
+	// Chrome version <=89
+	return metrics.VisualViewport.PageX, metrics.VisualViewport.PageY
+}
+
+func GetContentSizeWH(metrics *page.GetLayoutMetricsReply) (width float64, height float64) {
+	if metrics.CSSContentSize.Width > 0 {
+		return metrics.CSSContentSize.Width, metrics.CSSContentSize.Height
+	}
+
+	// Chrome version <=89
+	return metrics.ContentSize.Width, metrics.ContentSize.Height
+}
@@ -0,0 +1,23 @@
+package drivers
+
+import "github.com/MontFerret/ferret/pkg/runtime/logging"
+
+type (
+	// Console defines how page console messages and uncaught exceptions are forwarded into the logger.
+	Console struct {
+		// Level is a level to log console messages and uncaught exceptions with.
+		// If not set, the level is derived from a message type, e.g. console.warn is logged as a warning
+		// and uncaught exceptions are logged as errors.
+		Level string
+	}
+)
+
+func IsConsoleLevelValid(level string) bool {
+	if level == "" {
+		return true
+	}
+
+	_, err := logging.ParseLevel(level)
+
+	return err == nil
+}
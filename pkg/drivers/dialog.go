@@ -0,0 +1,28 @@
+package drivers
+
+const (
+	// DialogActionDefault accepts "beforeunload" dialogs and dismisses all the others.
+	DialogActionDefault DialogAction = ""
+	DialogActionAccept  DialogAction = "accept"
+	DialogActionDismiss DialogAction = "dismiss"
+	// DialogActionNone leaves dialogs open until they get handled explicitly.
+	DialogActionNone DialogAction = "none"
+)
+
+type (
+	DialogAction string
+
+	Dialog struct {
+		Action     DialogAction
+		PromptText string
+	}
+)
+
+func IsDialogActionValid(action string) bool {
+	value := DialogAction(action)
+
+	return value == DialogActionDefault ||
+		value == DialogActionAccept ||
+		value == DialogActionDismiss ||
+		value == DialogActionNone
+}
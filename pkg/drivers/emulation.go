@@ -0,0 +1,100 @@
+package drivers
+
+const (
+	ColorSchemeLight        ColorScheme = "light"
+	ColorSchemeDark         ColorScheme = "dark"
+	ColorSchemeNoPreference ColorScheme = "no-preference"
+)
+
+type (
+	ColorScheme string
+
+	Geolocation struct {
+		Latitude  float64
+		Longitude float64
+		Accuracy  float64
+	}
+
+	// NetworkConditions describes network conditions to emulate.
+	// Latency is set in milliseconds, throughputs are set in bytes per second.
+	// Zero throughput means no throttling.
+	NetworkConditions struct {
+		Offline            bool
+		Latency            float64
+		DownloadThroughput float64
+		UploadThroughput   float64
+	}
+
+	Emulation struct {
+		// Device is a name of a preset from the Devices catalog.
+		// The preset's viewport and user agent are used unless they are set explicitly.
+		Device        string
+		Geolocation   *Geolocation
+		Timezone      string
+		Locale        string
+		ColorScheme   ColorScheme
+		ReducedMotion bool
+		Touch         bool
+		// CPUThrottling is a slowdown factor, 1 means no throttling.
+		CPUThrottling float64
+		Network       *NetworkConditions
+	}
+
+	Device struct {
+		UserAgent string
+		Viewport  Viewport
+		Touch     bool
+	}
+)
+
+// Devices is a catalog of named device presets.
+var Devices = map[string]Device{
+	"Desktop HD": {
+		Viewport: Viewport{Width: 1366, Height: 768, ScaleFactor: 1},
+	},
+	"Desktop Full HD": {
+		Viewport: Viewport{Width: 1920, Height: 1080, ScaleFactor: 1},
+	},
+	"iPhone SE": {
+		UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 15_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1",
+		Viewport:  Viewport{Width: 375, Height: 667, ScaleFactor: 2, Mobile: true},
+		Touch:     true,
+	},
+	"iPhone 13": {
+		UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 15_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1",
+		Viewport:  Viewport{Width: 390, Height: 844, ScaleFactor: 3, Mobile: true},
+		Touch:     true,
+	},
+	"iPad Mini": {
+		UserAgent: "Mozilla/5.0 (iPad; CPU OS 15_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1",
+		Viewport:  Viewport{Width: 768, Height: 1024, ScaleFactor: 2, Mobile: true},
+		Touch:     true,
+	},
+	"iPad Pro 11": {
+		UserAgent: "Mozilla/5.0 (iPad; CPU OS 15_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1",
+		Viewport:  Viewport{Width: 834, Height: 1194, ScaleFactor: 2, Mobile: true},
+		Touch:     true,
+	},
+	"Pixel 5": {
+		UserAgent: "Mozilla/5.0 (Linux; Android 11; Pixel 5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/94.0.4606.71 Mobile Safari/537.36",
+		Viewport:  Viewport{Width: 393, Height: 851, ScaleFactor: 2.75, Mobile: true},
+		Touch:     true,
+	},
+	"Galaxy S9+": {
+		UserAgent: "Mozilla/5.0 (Linux; Android 8.0.0; SM-G965U Build/R16NW) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/94.0.4606.71 Mobile Safari/537.36",
+		Viewport:  Viewport{Width: 320, Height: 658, ScaleFactor: 4.5, Mobile: true},
+		Touch:     true,
+	},
+}
+
+func GetDevice(name string) (Device, bool) {
+	device, found := Devices[name]
+
+	return device, found
+}
+
+func IsColorSchemeValid(scheme string) bool {
+	value := ColorScheme(scheme)
+
+	return value == ColorSchemeLight || value == ColorSchemeDark || value == ColorSchemeNoPreference
+}
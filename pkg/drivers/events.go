@@ -4,4 +4,9 @@ const (
 	NavigationEvent = "navigation"
 	RequestEvent    = "request"
 	ResponseEvent   = "response"
+	DialogEvent     = "dialog"
+	PageEvent       = "page"
+	MetricsEvent    = "metrics"
+	ConsoleEvent    = "console"
+	ErrorEvent      = "error"
 )
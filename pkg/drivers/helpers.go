@@ -1,6 +1,8 @@
 package drivers
 
 import (
+	"strings"
+
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 	"github.com/MontFerret/ferret/pkg/runtime/values/types"
@@ -54,6 +56,20 @@ func ToQuerySelector(value core.Value) (QuerySelector, error) {
 	case QuerySelector:
 		return v, nil
 	case values.String:
+		str := v.String()
+
+		// prefixes like "text=" are not valid in CSS, so they unambiguously denote other selector kinds
+		for _, prefix := range selectorPrefixes {
+			if strings.HasPrefix(str, prefix.value) {
+				return prefix.create(values.NewString(strings.TrimPrefix(str, prefix.value))), nil
+			}
+		}
+
+		// ">>>" is not valid in CSS either
+		if strings.Contains(str, ShadowCombinator) {
+			return NewShadowSelector(v), nil
+		}
+
 		return NewCSSSelector(v), nil
 	default:
 		return QuerySelector{}, core.TypeError(value.Type(), types.String, QuerySelectorType)
@@ -7,6 +7,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
 
 func TestSetDefaultParams(t *testing.T) {
@@ -39,3 +40,41 @@ func TestSetDefaultParams(t *testing.T) {
 		So(params.Cookies, ShouldNotBeNil)
 	})
 }
+
+func TestToQuerySelector(t *testing.T) {
+	Convey("Should create a CSS selector from a string", t, func() {
+		selector, err := drivers.ToQuerySelector(values.NewString(".foo .bar"))
+
+		So(err, ShouldBeNil)
+		So(selector.Kind(), ShouldEqual, drivers.CSSSelector)
+	})
+
+	Convey("Should create a Shadow selector from a string with a deep combinator", t, func() {
+		selector, err := drivers.ToQuerySelector(values.NewString("#host >>> .bar"))
+
+		So(err, ShouldBeNil)
+		So(selector.Kind(), ShouldEqual, drivers.ShadowSelector)
+		So(selector.String(), ShouldEqual, "#host >>> .bar")
+	})
+
+	Convey("Should create selectors of other kinds from prefixed strings", t, func() {
+		cases := map[string]drivers.QuerySelectorKind{
+			"text=Sign in":                drivers.TextSelector,
+			`role=button[name="Sign in"]`: drivers.RoleSelector,
+			"label=Email":                 drivers.LabelSelector,
+			"placeholder=you@example.com": drivers.PlaceholderSelector,
+		}
+
+		for str, kind := range cases {
+			selector, err := drivers.ToQuerySelector(values.NewString(str))
+
+			So(err, ShouldBeNil)
+			So(selector.Kind(), ShouldEqual, kind)
+		}
+
+		selector, err := drivers.ToQuerySelector(values.NewString("text=Sign in"))
+
+		So(err, ShouldBeNil)
+		So(selector.String(), ShouldEqual, "Sign in")
+	})
+}
@@ -224,6 +224,10 @@ func (doc *HTMLDocument) MoveMouseByXY(_ context.Context, _, _ values.Float) err
 	return core.ErrNotSupported
 }
 
+func (doc *HTMLDocument) Evaluate(_ context.Context, _ values.String, _ ...core.Value) (core.Value, error) {
+	return values.None, core.ErrNotSupported
+}
+
 func (doc *HTMLDocument) Close() error {
 	return nil
 }
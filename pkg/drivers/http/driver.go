@@ -88,13 +88,13 @@ func (drv *Driver) Name() string {
 }
 
 func (drv *Driver) Open(ctx context.Context, params drivers.Params) (drivers.HTMLPage, error) {
+	params = drivers.SetDefaultParams(drv.options.Options, drivers.SetSessionParams(params))
+
 	req, err := http.NewRequest(http.MethodGet, params.URL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	params = drivers.SetDefaultParams(drv.options.Options, params)
-
 	drv.makeRequest(ctx, req, params)
 
 	resp, err := drv.client.Do(req)
@@ -294,9 +294,7 @@ func (el *HTMLElement) GetChildNode(_ context.Context, idx values.Int) (core.Val
 }
 
 func (el *HTMLElement) QuerySelector(_ context.Context, selector drivers.QuerySelector) (core.Value, error) {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		if selection.Length() == 0 {
 			return values.None, drivers.ErrNotFound
 		}
@@ -324,9 +322,7 @@ func (el *HTMLElement) QuerySelector(_ context.Context, selector drivers.QuerySe
 }
 
 func (el *HTMLElement) QuerySelectorAll(_ context.Context, selector drivers.QuerySelector) (*values.Array, error) {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		if selection.Length() == 0 {
 			return values.NewArray(0), nil
 		}
@@ -352,9 +348,7 @@ func (el *HTMLElement) XPath(_ context.Context, expression values.String) (core.
 }
 
 func (el *HTMLElement) SetInnerHTMLBySelector(ctx context.Context, selector drivers.QuerySelector, innerHTML values.String) error {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		if selection.Length() == 0 {
 			return drivers.ErrNotFound
 		}
@@ -376,9 +370,7 @@ func (el *HTMLElement) SetInnerHTMLBySelector(ctx context.Context, selector driv
 }
 
 func (el *HTMLElement) GetInnerHTMLBySelector(ctx context.Context, selector drivers.QuerySelector) (values.String, error) {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		if selection.Length() == 0 {
 			return values.EmptyString, drivers.ErrNotFound
 		}
@@ -406,9 +398,8 @@ func (el *HTMLElement) GetInnerHTMLBySelector(ctx context.Context, selector driv
 }
 
 func (el *HTMLElement) GetInnerHTMLBySelectorAll(ctx context.Context, selector drivers.QuerySelector) (*values.Array, error) {
-	if selector.Kind() == drivers.CSSSelector {
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		var err error
-		selection := el.selection.Find(selector.String())
 		arr := values.NewArray(selection.Length())
 
 		selection.EachWithBreak(func(_ int, selection *goquery.Selection) bool {
@@ -449,9 +440,7 @@ func (el *HTMLElement) GetInnerHTMLBySelectorAll(ctx context.Context, selector d
 }
 
 func (el *HTMLElement) GetInnerTextBySelector(ctx context.Context, selector drivers.QuerySelector) (values.String, error) {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		if selection.Length() == 0 {
 			return values.EmptyString, drivers.ErrNotFound
 		}
@@ -473,9 +462,7 @@ func (el *HTMLElement) GetInnerTextBySelector(ctx context.Context, selector driv
 }
 
 func (el *HTMLElement) SetInnerTextBySelector(ctx context.Context, selector drivers.QuerySelector, innerText values.String) error {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		if selection.Length() == 0 {
 			return drivers.ErrNotFound
 		}
@@ -499,8 +486,7 @@ func (el *HTMLElement) SetInnerTextBySelector(ctx context.Context, selector driv
 }
 
 func (el *HTMLElement) GetInnerTextBySelectorAll(ctx context.Context, selector drivers.QuerySelector) (*values.Array, error) {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		arr := values.NewArray(selection.Length())
 
 		selection.Each(func(_ int, selection *goquery.Selection) {
@@ -528,9 +514,7 @@ func (el *HTMLElement) GetInnerTextBySelectorAll(ctx context.Context, selector d
 }
 
 func (el *HTMLElement) CountBySelector(_ context.Context, selector drivers.QuerySelector) (values.Int, error) {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		return values.NewInt(selection.Length()), nil
 	}
 
@@ -546,9 +530,7 @@ func (el *HTMLElement) CountBySelector(_ context.Context, selector drivers.Query
 }
 
 func (el *HTMLElement) ExistsBySelector(_ context.Context, selector drivers.QuerySelector) (values.Boolean, error) {
-	if selector.Kind() == drivers.CSSSelector {
-		selection := el.selection.Find(selector.String())
-
+	if selection, ok := findBySelector(el.selection, selector); ok {
 		if selection.Length() == 0 {
 			return values.False, nil
 		}
@@ -619,6 +601,34 @@ func (el *HTMLElement) ClickBySelectorAll(_ context.Context, _ drivers.QuerySele
 	return core.ErrNotSupported
 }
 
+func (el *HTMLElement) ClickWithButton(_ context.Context, _ drivers.MouseButton, _ values.Int) error {
+	return core.ErrNotSupported
+}
+
+func (el *HTMLElement) DragTo(_ context.Context, _ drivers.HTMLElement) error {
+	return core.ErrNotSupported
+}
+
+func (el *HTMLElement) Wheel(_ context.Context, _, _ values.Float) error {
+	return core.ErrNotSupported
+}
+
+func (el *HTMLElement) Hold(_ context.Context, _ values.Int) error {
+	return core.ErrNotSupported
+}
+
+func (el *HTMLElement) Tap(_ context.Context) error {
+	return core.ErrNotSupported
+}
+
+func (el *HTMLElement) Swipe(_ context.Context, _, _ values.Float) error {
+	return core.ErrNotSupported
+}
+
+func (el *HTMLElement) Pinch(_ context.Context, _ values.Float) error {
+	return core.ErrNotSupported
+}
+
 func (el *HTMLElement) Clear(_ context.Context) error {
 	return core.ErrNotSupported
 }
@@ -635,6 +645,14 @@ func (el *HTMLElement) InputBySelector(_ context.Context, _ drivers.QuerySelecto
 	return core.ErrNotSupported
 }
 
+func (el *HTMLElement) SetFiles(_ context.Context, _ *values.Array) error {
+	return core.ErrNotSupported
+}
+
+func (el *HTMLElement) SetFilesBySelector(_ context.Context, _ drivers.QuerySelector, _ *values.Array) error {
+	return core.ErrNotSupported
+}
+
 func (el *HTMLElement) Press(_ context.Context, _ []values.String, _ values.Int) error {
 	return core.ErrNotSupported
 }
@@ -723,6 +741,18 @@ func (el *HTMLElement) WaitForClassBySelectorAll(_ context.Context, _ drivers.Qu
 	return core.ErrNotSupported
 }
 
+func (el *HTMLElement) Evaluate(_ context.Context, _ values.String, _ ...core.Value) (core.Value, error) {
+	return values.None, core.ErrNotSupported
+}
+
+func (el *HTMLElement) CaptureScreenshot(_ context.Context, _ drivers.ScreenshotParams) (values.Binary, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (el *HTMLElement) GetAccessibilityTree(_ context.Context, _ drivers.AccessibilityParams) (*values.Object, error) {
+	return nil, core.ErrNotSupported
+}
+
 func (el *HTMLElement) ensureStyles(ctx context.Context) error {
 	if el.styles == nil {
 		styles, err := el.parseStyles(ctx)
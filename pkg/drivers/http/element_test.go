@@ -412,6 +412,28 @@ func TestElement(t *testing.T) {
 		So(v, ShouldEqual, "img")
 	})
 
+	Convey(".QuerySelector with a shadow selector", t, func() {
+		buff := bytes.NewBuffer([]byte(doc))
+
+		doc, err := goquery.NewDocumentFromReader(buff)
+
+		So(err, ShouldBeNil)
+
+		el, err := http.NewHTMLElement(doc.Selection)
+
+		So(err, ShouldBeNil)
+
+		found, err := el.QuerySelector(context.Background(), drivers.NewShadowSelector("body >>> .card-img-top:nth-child(1)"))
+
+		So(err, ShouldBeNil)
+		So(found, ShouldNotEqual, values.None)
+
+		v, err := found.(drivers.HTMLNode).GetNodeName(context.Background())
+
+		So(err, ShouldBeNil)
+		So(v, ShouldEqual, "img")
+	})
+
 	Convey(".CountBySelector", t, func() {
 		buff := bytes.NewBuffer([]byte(doc))
 
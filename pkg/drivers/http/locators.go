@@ -0,0 +1,419 @@
+package http
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+var (
+	roleSelectorExp = regexp.MustCompile(`^\s*([\w-]+)\s*(?:\[\s*name\s*=\s*(.*?)\s*\])?\s*$`)
+
+	ignoredTextTags = map[string]bool{
+		"head":     true,
+		"noscript": true,
+		"script":   true,
+		"style":    true,
+		"template": true,
+	}
+
+	buttonInputTypes = map[string]bool{
+		"button": true,
+		"reset":  true,
+		"submit": true,
+	}
+
+	labelableTags = map[string]bool{
+		"button":   true,
+		"input":    true,
+		"meter":    true,
+		"output":   true,
+		"progress": true,
+		"select":   true,
+		"textarea": true,
+	}
+
+	implicitRoles = map[string]string{
+		"article":  "article",
+		"aside":    "complementary",
+		"button":   "button",
+		"dialog":   "dialog",
+		"footer":   "contentinfo",
+		"form":     "form",
+		"h1":       "heading",
+		"h2":       "heading",
+		"h3":       "heading",
+		"h4":       "heading",
+		"h5":       "heading",
+		"h6":       "heading",
+		"header":   "banner",
+		"hr":       "separator",
+		"li":       "listitem",
+		"main":     "main",
+		"nav":      "navigation",
+		"ol":       "list",
+		"option":   "option",
+		"p":        "paragraph",
+		"progress": "progressbar",
+		"table":    "table",
+		"td":       "cell",
+		"textarea": "textbox",
+		"th":       "columnheader",
+		"tr":       "row",
+		"ul":       "list",
+	}
+
+	inputRoles = map[string]string{
+		"button":   "button",
+		"checkbox": "checkbox",
+		"color":    "",
+		"file":     "",
+		"hidden":   "",
+		"image":    "button",
+		"number":   "spinbutton",
+		"radio":    "radio",
+		"range":    "slider",
+		"reset":    "button",
+		"search":   "searchbox",
+		"submit":   "button",
+	}
+
+	nameFromContentRoles = map[string]bool{
+		"button":       true,
+		"cell":         true,
+		"checkbox":     true,
+		"columnheader": true,
+		"heading":      true,
+		"link":         true,
+		"listitem":     true,
+		"menuitem":     true,
+		"option":       true,
+		"radio":        true,
+		"row":          true,
+		"switch":       true,
+		"tab":          true,
+		"tooltip":      true,
+		"treeitem":     true,
+	}
+)
+
+// findBySelector finds elements matching a given selector.
+// Returns false if the selector cannot be resolved with goquery.
+func findBySelector(selection *goquery.Selection, selector drivers.QuerySelector) (*goquery.Selection, bool) {
+	switch selector.Kind() {
+	case drivers.CSSSelector:
+		return selection.Find(selector.String()), true
+	case drivers.ShadowSelector:
+		// static documents have no shadow roots, therefore shadow piercing selectors are treated as descendant ones
+		return selection.Find(strings.ReplaceAll(selector.String(), drivers.ShadowCombinator, " ")), true
+	case drivers.TextSelector:
+		return findByText(selection, selector.String()), true
+	case drivers.RoleSelector:
+		return findByRole(selection, selector.String()), true
+	case drivers.LabelSelector:
+		return findByLabel(selection, selector.String()), true
+	case drivers.PlaceholderSelector:
+		return findByPlaceholder(selection, selector.String()), true
+	default:
+		return nil, false
+	}
+}
+
+func findByText(selection *goquery.Selection, text string) *goquery.Selection {
+	isMatched := func(node *html.Node) bool {
+		return node.Type == html.ElementNode && !isTextIgnored(node) && matchText(textOf(node), text)
+	}
+
+	return selection.Find("*").FilterFunction(func(_ int, item *goquery.Selection) bool {
+		node := item.Get(0)
+
+		if !isMatched(node) {
+			return false
+		}
+
+		// only the innermost elements containing the text
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if isMatched(child) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+func findByRole(selection *goquery.Selection, value string) *goquery.Selection {
+	parsed := roleSelectorExp.FindStringSubmatch(value)
+
+	return selection.Find("*").FilterFunction(func(_ int, item *goquery.Selection) bool {
+		if parsed == nil {
+			return false
+		}
+
+		node := item.Get(0)
+		role := roleOf(node)
+
+		if role == "" || role != strings.ToLower(parsed[1]) {
+			return false
+		}
+
+		return parsed[2] == "" || matchText(nameOf(node, role), parsed[2])
+	})
+}
+
+func findByLabel(selection *goquery.Selection, text string) *goquery.Selection {
+	return selection.Find("*").FilterFunction(func(_ int, item *goquery.Selection) bool {
+		node := item.Get(0)
+
+		if label, ok := getAttr(node, "aria-label"); ok && matchText(label, text) {
+			return true
+		}
+
+		if ids, ok := getAttr(node, "aria-labelledby"); ok && matchText(textOfIDs(node, ids), text) {
+			return true
+		}
+
+		for _, label := range labelsOf(node) {
+			if matchText(textOf(label), text) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+func findByPlaceholder(selection *goquery.Selection, text string) *goquery.Selection {
+	return selection.Find("[placeholder]").FilterFunction(func(_ int, item *goquery.Selection) bool {
+		placeholder, _ := item.Attr("placeholder")
+
+		return matchText(placeholder, text)
+	})
+}
+
+// matchText matches text against an expected value.
+// Quoted values must match exactly, other values are matched as case-insensitive substrings.
+// Whitespaces are normalized in both cases.
+func matchText(actual, expected string) bool {
+	value := normalizeText(actual)
+
+	if len(expected) > 1 && strings.HasPrefix(expected, `"`) && strings.HasSuffix(expected, `"`) {
+		return value == normalizeText(expected[1:len(expected)-1])
+	}
+
+	return strings.Contains(strings.ToLower(value), strings.ToLower(normalizeText(expected)))
+}
+
+func normalizeText(str string) string {
+	return strings.Join(strings.Fields(str), " ")
+}
+
+func isTextIgnored(node *html.Node) bool {
+	for n := node; n != nil; n = n.Parent {
+		if n.Type == html.ElementNode && ignoredTextTags[n.Data] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func textOf(node *html.Node) string {
+	if node.Data == "input" {
+		if kind, _ := getAttr(node, "type"); buttonInputTypes[strings.ToLower(kind)] {
+			value, _ := getAttr(node, "value")
+
+			return value
+		}
+	}
+
+	var sb strings.Builder
+
+	var collect func(n *html.Node)
+	collect = func(n *html.Node) {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			switch child.Type {
+			case html.TextNode:
+				sb.WriteString(child.Data)
+			case html.ElementNode:
+				if !ignoredTextTags[child.Data] {
+					collect(child)
+				}
+			}
+		}
+	}
+
+	collect(node)
+
+	return sb.String()
+}
+
+func roleOf(node *html.Node) string {
+	if explicit, _ := getAttr(node, "role"); strings.TrimSpace(explicit) != "" {
+		return strings.ToLower(strings.Fields(explicit)[0])
+	}
+
+	switch node.Data {
+	case "a", "area":
+		if _, ok := getAttr(node, "href"); ok {
+			return "link"
+		}
+
+		return ""
+	case "img":
+		if alt, ok := getAttr(node, "alt"); ok && alt == "" {
+			return "presentation"
+		}
+
+		return "img"
+	case "input":
+		kind, _ := getAttr(node, "type")
+		role, found := inputRoles[strings.ToLower(kind)]
+
+		if !found {
+			return "textbox"
+		}
+
+		return role
+	case "select":
+		_, multiple := getAttr(node, "multiple")
+		size, _ := getAttr(node, "size")
+
+		if multiple || (size != "" && size != "0" && size != "1") {
+			return "listbox"
+		}
+
+		return "combobox"
+	default:
+		return implicitRoles[node.Data]
+	}
+}
+
+func nameOf(node *html.Node, role string) string {
+	if ids, ok := getAttr(node, "aria-labelledby"); ok && strings.TrimSpace(ids) != "" {
+		return textOfIDs(node, ids)
+	}
+
+	if label, _ := getAttr(node, "aria-label"); label != "" {
+		return label
+	}
+
+	if labels := labelsOf(node); len(labels) > 0 {
+		texts := make([]string, 0, len(labels))
+
+		for _, label := range labels {
+			texts = append(texts, textOf(label))
+		}
+
+		return strings.Join(texts, " ")
+	}
+
+	kind, _ := getAttr(node, "type")
+	kind = strings.ToLower(kind)
+
+	if node.Data == "input" && buttonInputTypes[kind] {
+		value, _ := getAttr(node, "value")
+
+		return value
+	}
+
+	if node.Data == "img" || (node.Data == "input" && kind == "image") {
+		alt, _ := getAttr(node, "alt")
+
+		return alt
+	}
+
+	if nameFromContentRoles[role] {
+		return textOf(node)
+	}
+
+	if title, _ := getAttr(node, "title"); title != "" {
+		return title
+	}
+
+	placeholder, _ := getAttr(node, "placeholder")
+
+	return placeholder
+}
+
+// labelsOf returns label elements associated with a given form control.
+func labelsOf(node *html.Node) []*html.Node {
+	if !labelableTags[node.Data] {
+		return nil
+	}
+
+	if kind, _ := getAttr(node, "type"); node.Data == "input" && strings.ToLower(kind) == "hidden" {
+		return nil
+	}
+
+	labels := make([]*html.Node, 0, 1)
+
+	for n := node.Parent; n != nil; n = n.Parent {
+		if n.Type == html.ElementNode && n.Data == "label" {
+			if _, ok := getAttr(n, "for"); !ok {
+				labels = append(labels, n)
+			}
+
+			break
+		}
+	}
+
+	if id, _ := getAttr(node, "id"); id != "" {
+		walkElements(rootOf(node), func(n *html.Node) {
+			if n.Data == "label" {
+				if target, _ := getAttr(n, "for"); target == id {
+					labels = append(labels, n)
+				}
+			}
+		})
+	}
+
+	return labels
+}
+
+// textOfIDs returns joined text of elements referenced by a space separated list of ids.
+func textOfIDs(node *html.Node, ids string) string {
+	texts := make([]string, 0, 1)
+
+	for _, id := range strings.Fields(ids) {
+		walkElements(rootOf(node), func(n *html.Node) {
+			if value, _ := getAttr(n, "id"); value == id {
+				texts = append(texts, textOf(n))
+			}
+		})
+	}
+
+	return strings.Join(texts, " ")
+}
+
+func rootOf(node *html.Node) *html.Node {
+	for node.Parent != nil {
+		node = node.Parent
+	}
+
+	return node
+}
+
+func walkElements(node *html.Node, fn func(n *html.Node)) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode {
+			fn(child)
+		}
+
+		walkElements(child, fn)
+	}
+}
+
+func getAttr(node *html.Node, name string) (string, bool) {
+	for _, attr := range node.Attr {
+		if attr.Key == name {
+			return attr.Val, true
+		}
+	}
+
+	return "", false
+}
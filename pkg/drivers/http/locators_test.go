@@ -0,0 +1,141 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+func TestLocators(t *testing.T) {
+	doc := `
+<html lang="en">
+<head>
+	<title>Sign in</title>
+	<script>const label = "Sign in";</script>
+</head>
+<body>
+	<h1>Welcome back</h1>
+	<form>
+		<label for="email">Email address</label>
+		<input id="email" type="email" placeholder="you@example.com">
+		<label>Password <input id="password" type="password"></label>
+		<input id="remember" type="checkbox" aria-label="Remember me">
+		<button id="submit" type="submit"><span>Sign in</span></button>
+		<input id="cancel" type="button" value="Cancel">
+		<a id="forgot" href="/forgot">Forgot password?</a>
+	</form>
+</body>
+</html>
+`
+
+	query := func(selector drivers.QuerySelector) *values.Array {
+		buff := bytes.NewBuffer([]byte(doc))
+
+		d, err := goquery.NewDocumentFromReader(buff)
+
+		So(err, ShouldBeNil)
+
+		el, err := http.NewHTMLElement(d.Selection)
+
+		So(err, ShouldBeNil)
+
+		found, err := el.QuerySelectorAll(context.Background(), selector)
+
+		So(err, ShouldBeNil)
+
+		return found
+	}
+
+	attr := func(arr *values.Array, idx int, name values.String) string {
+		el := arr.Get(values.NewInt(idx)).(drivers.HTMLElement)
+		value, err := el.GetAttribute(context.Background(), name)
+
+		So(err, ShouldBeNil)
+
+		return value.String()
+	}
+
+	Convey("Text selector", t, func() {
+		Convey("Should find the innermost elements containing the text", func() {
+			found := query(drivers.NewTextSelector("sign in"))
+
+			So(found.Length(), ShouldEqual, 1)
+
+			name, err := found.Get(0).(drivers.HTMLElement).GetNodeName(context.Background())
+
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, "span")
+		})
+
+		Convey("Should match quoted text exactly", func() {
+			So(query(drivers.NewTextSelector(`"Welcome"`)).Length(), ShouldEqual, 0)
+			So(query(drivers.NewTextSelector(`"Welcome back"`)).Length(), ShouldEqual, 1)
+		})
+
+		Convey("Should use values of button inputs", func() {
+			found := query(drivers.NewTextSelector("Cancel"))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "cancel")
+		})
+	})
+
+	Convey("Role selector", t, func() {
+		Convey("Should find elements by implicit role", func() {
+			So(query(drivers.NewRoleSelector("button")).Length(), ShouldEqual, 2)
+			So(query(drivers.NewRoleSelector("heading")).Length(), ShouldEqual, 1)
+		})
+
+		Convey("Should find elements by role and accessible name", func() {
+			found := query(drivers.NewRoleSelector(`button[name="Sign in"]`))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "submit")
+
+			found = query(drivers.NewRoleSelector("checkbox[name=remember]"))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "remember")
+
+			found = query(drivers.NewRoleSelector("textbox[name=Email]"))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "email")
+		})
+	})
+
+	Convey("Label selector", t, func() {
+		Convey("Should find controls by label text", func() {
+			found := query(drivers.NewLabelSelector("Email address"))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "email")
+
+			found = query(drivers.NewLabelSelector("Password"))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "password")
+
+			found = query(drivers.NewLabelSelector("Remember me"))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "remember")
+		})
+	})
+
+	Convey("Placeholder selector", t, func() {
+		Convey("Should find elements by placeholder", func() {
+			found := query(drivers.NewPlaceholderSelector("@example.com"))
+
+			So(found.Length(), ShouldEqual, 1)
+			So(attr(found, 0, "id"), ShouldEqual, "email")
+		})
+	})
+}
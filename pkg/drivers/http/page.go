@@ -203,6 +203,22 @@ func (p *HTMLPage) CaptureScreenshot(_ context.Context, _ drivers.ScreenshotPara
 	return nil, core.ErrNotSupported
 }
 
+func (p *HTMLPage) GetAccessibilityTree(_ context.Context, _ drivers.AccessibilityParams) (*values.Object, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (p *HTMLPage) GetMetrics(_ context.Context) (*values.Object, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (p *HTMLPage) StartCoverage(_ context.Context, _ values.Boolean) error {
+	return core.ErrNotSupported
+}
+
+func (p *HTMLPage) StopCoverage(_ context.Context) (*values.Array, error) {
+	return nil, core.ErrNotSupported
+}
+
 func (p *HTMLPage) WaitForNavigation(_ context.Context, _ values.String) error {
 	return core.ErrNotSupported
 }
@@ -223,6 +239,46 @@ func (p *HTMLPage) NavigateForward(_ context.Context, _ values.Int) (values.Bool
 	return false, core.ErrNotSupported
 }
 
+func (p *HTMLPage) GetStorage(_ context.Context, _ drivers.StorageType) (*values.Object, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (p *HTMLPage) SetStorage(_ context.Context, _ drivers.StorageType, _ *values.Object) error {
+	return core.ErrNotSupported
+}
+
+func (p *HTMLPage) ClearStorage(_ context.Context, _ drivers.StorageType) error {
+	return core.ErrNotSupported
+}
+
+func (p *HTMLPage) GetIndexedDBNames(_ context.Context) (*values.Array, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (p *HTMLPage) GetIndexedDBItems(_ context.Context, _, _ values.String) (*values.Array, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (p *HTMLPage) Intercept(_ context.Context, _ ...drivers.InterceptRule) error {
+	return core.ErrNotSupported
+}
+
+func (p *HTMLPage) GetPages(_ context.Context) (*values.Array, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (p *HTMLPage) BringToFront(_ context.Context) error {
+	return core.ErrNotSupported
+}
+
+func (p *HTMLPage) GetHAR(_ context.Context) (*values.Object, error) {
+	return nil, core.ErrNotSupported
+}
+
+func (p *HTMLPage) HandleDialog(_ context.Context, _ values.Boolean, _ values.String) error {
+	return core.ErrNotSupported
+}
+
 func (p *HTMLPage) Subscribe(_ context.Context, _ events.Subscription) (events.Stream, error) {
 	return nil, core.ErrNotSupported
 }
@@ -0,0 +1,17 @@
+package drivers
+
+const (
+	// InputProfileDefault moves the mouse in straight lines and types with a steady pace.
+	InputProfileDefault InputProfile = ""
+	// InputProfileHuman moves the mouse along curved paths and types with a variable pace and occasional typos.
+	InputProfileHuman InputProfile = "human"
+)
+
+type InputProfile string
+
+func IsInputProfileValid(profile string) bool {
+	value := InputProfile(profile)
+
+	return value == InputProfileDefault ||
+		value == InputProfileHuman
+}
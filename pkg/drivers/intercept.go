@@ -0,0 +1,26 @@
+package drivers
+
+type (
+	// InterceptRule describes how to handle requests matching its URL pattern and resource type.
+	// If Response is set, matching requests are fulfilled with it without reaching a server,
+	// otherwise they are continued with modifications described by Request.
+	InterceptRule struct {
+		URL          string
+		ResourceType string
+		Request      *InterceptRequest
+		Response     *InterceptResponse
+	}
+
+	InterceptRequest struct {
+		URL     string
+		Method  string
+		Headers *HTTPHeaders
+		Body    []byte
+	}
+
+	InterceptResponse struct {
+		StatusCode int
+		Headers    *HTTPHeaders
+		Body       []byte
+	}
+)
@@ -0,0 +1,16 @@
+package drivers
+
+const (
+	MouseButtonLeft   MouseButton = "left"
+	MouseButtonRight  MouseButton = "right"
+	MouseButtonMiddle MouseButton = "middle"
+)
+
+const (
+	// DefaultHoldDuration is a default duration in ms of press-and-hold gestures.
+	DefaultHoldDuration = 1000
+	// DefaultSwipeDistance is a default distance in px of swipe gestures.
+	DefaultSwipeDistance = 100
+)
+
+type MouseButton string
@@ -24,15 +24,29 @@ type (
 		Landscape   bool
 	}
 
+	// HARParams enables recording of page network traffic in HAR format.
+	HARParams struct {
+		// Content indicates whether to record response bodies.
+		Content bool
+	}
+
 	Params struct {
-		URL         string
-		UserAgent   string
-		KeepCookies bool
-		Cookies     *HTTPCookies
-		Headers     *HTTPHeaders
-		Viewport    *Viewport
-		Charset     string
-		Ignore      *Ignore
+		URL          string
+		UserAgent    string
+		KeepCookies  bool
+		Cookies      *HTTPCookies
+		Headers      *HTTPHeaders
+		Viewport     *Viewport
+		Charset      string
+		Ignore       *Ignore
+		Dialog       *Dialog
+		Console      *Console
+		Emulation    *Emulation
+		Storage      *Storage
+		Session      *Session
+		Intercept    []InterceptRule
+		HAR          *HARParams
+		InputProfile InputProfile
 	}
 
 	ParseParams struct {
@@ -12,6 +12,9 @@ import (
 
 // HTTPResponse HTTP response object.
 type (
+	// BodyLoader loads a response body on demand.
+	BodyLoader func(ctx context.Context) ([]byte, error)
+
 	HTTPResponse struct {
 		URL          string
 		StatusCode   int
@@ -19,6 +22,8 @@ type (
 		Headers      *HTTPHeaders
 		Body         []byte
 		ResponseTime float64
+		// LoadBody, if set, is used to load Body on the first access via GetBody.
+		LoadBody BodyLoader
 	}
 
 	// responseMarshal is a structure that repeats HTTPResponse. It allows
@@ -30,6 +35,7 @@ type (
 		Headers      *HTTPHeaders `json:"headers"`
 		Body         []byte       `json:"body"`
 		ResponseTime float64      `json:"response_time"`
+		LoadBody     BodyLoader   `json:"-"`
 	}
 )
 
@@ -73,6 +79,7 @@ func (resp *HTTPResponse) Hash() uint64 {
 	return values.Parse(resp).Hash()
 }
 
+// MarshalJSON serializes the response. A body that has not been loaded yet is serialized as null.
 func (resp *HTTPResponse) MarshalJSON() ([]byte, error) {
 	if resp == nil {
 		return values.None.MarshalJSON()
@@ -81,6 +88,22 @@ func (resp *HTTPResponse) MarshalJSON() ([]byte, error) {
 	return jettison.MarshalOpts(responseMarshal(*resp), jettison.NoHTMLEscaping())
 }
 
+// GetBody returns the response body loading it first, if needed.
+func (resp *HTTPResponse) GetBody(ctx context.Context) ([]byte, error) {
+	if resp.Body == nil && resp.LoadBody != nil {
+		body, err := resp.LoadBody(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = body
+		resp.LoadBody = nil
+	}
+
+	return resp.Body, nil
+}
+
 func (resp *HTTPResponse) GetIn(ctx context.Context, path []core.Value) (core.Value, core.PathError) {
 	if len(path) == 0 {
 		return resp, nil
@@ -115,7 +138,13 @@ func (resp *HTTPResponse) GetIn(ctx context.Context, path []core.Value) (core.Va
 
 		return out, nil
 	case "body":
-		return values.NewBinary(resp.Body), nil
+		body, err := resp.GetBody(ctx)
+
+		if err != nil {
+			return values.None, core.NewPathError(err, segmentIdx)
+		}
+
+		return values.NewBinary(body), nil
 	case "responseTime":
 		return values.NewFloat(resp.ResponseTime), nil
 	}
@@ -0,0 +1,56 @@
+package drivers_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+func TestHTTPResponse(t *testing.T) {
+	Convey("HTTPResponse", t, func() {
+		Convey(".MarshalJSON", func() {
+			Convey("Should serialize a loaded body", func() {
+				resp := &drivers.HTTPResponse{
+					StatusCode: 200,
+					LoadBody: func(_ context.Context) ([]byte, error) {
+						return []byte("foo"), nil
+					},
+				}
+
+				_, err := resp.GetBody(context.Background())
+
+				So(err, ShouldBeNil)
+
+				out, err := resp.MarshalJSON()
+
+				So(err, ShouldBeNil)
+
+				res := struct {
+					Body []byte `json:"body"`
+				}{}
+
+				So(json.Unmarshal(out, &res), ShouldBeNil)
+				So(string(res.Body), ShouldEqual, "foo")
+			})
+
+			Convey("Should serialize a body that has not been loaded as null", func() {
+				resp := &drivers.HTTPResponse{
+					StatusCode: 200,
+					LoadBody: func(_ context.Context) ([]byte, error) {
+						return nil, errors.New("must not be called")
+					},
+				}
+
+				out, err := resp.MarshalJSON()
+
+				So(err, ShouldBeNil)
+				So(string(out), ShouldContainSubstring, `"body":null`)
+			})
+		})
+	})
+}
@@ -5,6 +5,7 @@ import "github.com/MontFerret/ferret/pkg/runtime/values"
 const (
 	ScreenshotFormatPNG  ScreenshotFormat = "png"
 	ScreenshotFormatJPEG ScreenshotFormat = "jpeg"
+	ScreenshotFormatWebP ScreenshotFormat = "webp"
 )
 
 type (
@@ -17,13 +18,21 @@ type (
 		Height  values.Float
 		Format  ScreenshotFormat
 		Quality values.Int
+		// FullPage indicates whether to capture the whole scrollable page instead of the viewport.
+		// Clip coordinates are ignored.
+		FullPage values.Boolean
+		// OmitBackground indicates whether to make the default white background transparent.
+		// Only applicable to png and webp formats.
+		OmitBackground values.Boolean
 	}
 )
 
 func IsScreenshotFormatValid(format string) bool {
 	value := ScreenshotFormat(format)
 
-	return value == ScreenshotFormatPNG || value == ScreenshotFormatJPEG
+	return value == ScreenshotFormatPNG ||
+		value == ScreenshotFormatJPEG ||
+		value == ScreenshotFormatWebP
 }
 
 func NewDefaultHTMLPDFParams() PDFParams {
@@ -23,13 +23,42 @@ const (
 	UnknownSelector QuerySelectorKind = iota
 	CSSSelector
 	XPathSelector
+	// ShadowSelector is a CSS selector that pierces shadow roots.
+	// Parts separated by ">>>" are matched one inside another.
+	ShadowSelector
+	// TextSelector matches the innermost elements containing a given visible text.
+	TextSelector
+	// RoleSelector matches elements by ARIA role and, optionally, accessible name: button[name="Sign in"].
+	RoleSelector
+	// LabelSelector matches form controls by text of their labels.
+	LabelSelector
+	// PlaceholderSelector matches elements by their placeholder text.
+	PlaceholderSelector
 )
 
+// ShadowCombinator separates parts of a shadow piercing selector.
+const ShadowCombinator = ">>>"
+
 var (
+	selectorPrefixes = []struct {
+		value  string
+		create func(value values.String) QuerySelector
+	}{
+		{"text=", NewTextSelector},
+		{"role=", NewRoleSelector},
+		{"label=", NewLabelSelector},
+		{"placeholder=", NewPlaceholderSelector},
+	}
+
 	qsvStr = map[QuerySelectorKind]string{
-		UnknownSelector: "unknown",
-		CSSSelector:     "css",
-		XPathSelector:   "xpath",
+		UnknownSelector:     "unknown",
+		CSSSelector:         "css",
+		XPathSelector:       "xpath",
+		ShadowSelector:      "shadow",
+		TextSelector:        "text",
+		RoleSelector:        "role",
+		LabelSelector:       "label",
+		PlaceholderSelector: "placeholder",
 	}
 )
 
@@ -57,6 +86,41 @@ func NewXPathSelector(value values.String) QuerySelector {
 	}
 }
 
+func NewShadowSelector(value values.String) QuerySelector {
+	return QuerySelector{
+		kind:  ShadowSelector,
+		value: value,
+	}
+}
+
+func NewTextSelector(value values.String) QuerySelector {
+	return QuerySelector{
+		kind:  TextSelector,
+		value: value,
+	}
+}
+
+func NewRoleSelector(value values.String) QuerySelector {
+	return QuerySelector{
+		kind:  RoleSelector,
+		value: value,
+	}
+}
+
+func NewLabelSelector(value values.String) QuerySelector {
+	return QuerySelector{
+		kind:  LabelSelector,
+		value: value,
+	}
+}
+
+func NewPlaceholderSelector(value values.String) QuerySelector {
+	return QuerySelector{
+		kind:  PlaceholderSelector,
+		value: value,
+	}
+}
+
 func (q QuerySelector) Kind() QuerySelectorKind {
 	return q.kind
 }
@@ -0,0 +1,135 @@
+package drivers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Session represents a state of a browser session that can be restored in a new page.
+type Session struct {
+	URL     string
+	Cookies *HTTPCookies
+	Storage *Storage
+}
+
+// SaveSession exports a session state of a given page.
+// Web storages are exported only if the page has them.
+func SaveSession(ctx context.Context, page HTMLPage) (*Session, error) {
+	cookies, err := page.GetCookies(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "get cookies")
+	}
+
+	session := &Session{
+		URL:     page.GetURL().String(),
+		Cookies: cookies,
+	}
+
+	local, err := getStorageItems(ctx, page, StorageTypeLocal)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := getStorageItems(ctx, page, StorageTypeSession)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if local != nil || sess != nil {
+		session.Storage = &Storage{
+			Local:   local,
+			Session: sess,
+		}
+	}
+
+	return session, nil
+}
+
+// SetSessionParams applies a session state set in given params.
+// Explicitly set params values take precedence over the session ones.
+func SetSessionParams(params Params) Params {
+	session := params.Session
+
+	if session == nil {
+		return params
+	}
+
+	if params.URL == "" {
+		params.URL = session.URL
+	}
+
+	if session.Cookies != nil && session.Cookies.Length() > 0 {
+		cookies := NewHTTPCookies()
+
+		session.Cookies.ForEach(func(value HTTPCookie, _ values.String) bool {
+			cookies.Set(value)
+
+			return true
+		})
+
+		if params.Cookies != nil {
+			params.Cookies.ForEach(func(value HTTPCookie, _ values.String) bool {
+				cookies.Set(value)
+
+				return true
+			})
+		}
+
+		params.Cookies = cookies
+	}
+
+	if session.Storage != nil {
+		storage := &Storage{
+			Local:   make(map[string]string),
+			Session: make(map[string]string),
+		}
+
+		mergeStorageItems(storage.Local, session.Storage.Local)
+		mergeStorageItems(storage.Session, session.Storage.Session)
+
+		if params.Storage != nil {
+			mergeStorageItems(storage.Local, params.Storage.Local)
+			mergeStorageItems(storage.Session, params.Storage.Session)
+		}
+
+		params.Storage = storage
+	}
+
+	return params
+}
+
+func getStorageItems(ctx context.Context, page HTMLPage, storageType StorageType) (map[string]string, error) {
+	items, err := page.GetStorage(ctx, storageType)
+
+	if err != nil {
+		// pages without web storages, e.g. blank ones, have nothing to export
+		if errors.Is(err, core.ErrNotSupported) || errors.Is(err, core.ErrInvalidOperation) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "get %s storage", storageType)
+	}
+
+	res := make(map[string]string, items.Length())
+
+	items.ForEach(func(value core.Value, key string) bool {
+		res[key] = value.String()
+
+		return true
+	})
+
+	return res, nil
+}
+
+func mergeStorageItems(dst, src map[string]string) {
+	for key, value := range src {
+		dst[key] = value
+	}
+}
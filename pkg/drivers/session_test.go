@@ -0,0 +1,55 @@
+package drivers_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+func TestSetSessionParams(t *testing.T) {
+	Convey("Should take values from Session if not present in Params", t, func() {
+		params := drivers.SetSessionParams(drivers.Params{
+			Cookies: drivers.NewHTTPCookiesWith(map[string]drivers.HTTPCookie{
+				"theme": {Name: "theme", Value: "dark"},
+			}),
+			Storage: &drivers.Storage{
+				Local: map[string]string{"lang": "en"},
+			},
+			Session: &drivers.Session{
+				URL: "https://example.com/account",
+				Cookies: drivers.NewHTTPCookiesWith(map[string]drivers.HTTPCookie{
+					"session": {Name: "session", Value: "abc"},
+					"theme":   {Name: "theme", Value: "light"},
+				}),
+				Storage: &drivers.Storage{
+					Local:   map[string]string{"token": "secret", "lang": "de"},
+					Session: map[string]string{"step": "2"},
+				},
+			},
+		})
+
+		So(params.URL, ShouldEqual, "https://example.com/account")
+		So(params.Cookies.Length(), ShouldEqual, 2)
+
+		theme, _ := params.Cookies.Get("theme")
+		So(theme.Value, ShouldEqual, "dark")
+
+		So(params.Storage.Local, ShouldResemble, map[string]string{"token": "secret", "lang": "en"})
+		So(params.Storage.Session, ShouldResemble, map[string]string{"step": "2"})
+	})
+
+	Convey("Should keep explicitly set URL", t, func() {
+		params := drivers.SetSessionParams(drivers.Params{
+			URL: "https://example.com",
+			Session: &drivers.Session{
+				URL: "https://example.com/account",
+			},
+		})
+
+		So(params.URL, ShouldEqual, "https://example.com")
+		So(params.Cookies, ShouldBeNil)
+		So(params.Storage, ShouldBeNil)
+	})
+}
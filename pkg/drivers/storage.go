@@ -0,0 +1,24 @@
+package drivers
+
+const (
+	StorageTypeLocal   StorageType = "local"
+	StorageTypeSession StorageType = "session"
+)
+
+type (
+	StorageType string
+
+	// Storage contains web storage items to set before a page gets loaded.
+	// Items are set in the first document of the page URL origin, before any of its scripts run.
+	// IndexedDB databases cannot be preloaded, since drivers provide read-only access to them.
+	Storage struct {
+		Local   map[string]string
+		Session map[string]string
+	}
+)
+
+func IsStorageTypeValid(storageType string) bool {
+	value := StorageType(storageType)
+
+	return value == StorageTypeLocal || value == StorageTypeSession
+}
@@ -104,6 +104,20 @@ type (
 
 		ClickBySelectorAll(ctx context.Context, selector QuerySelector, count values.Int) error
 
+		ClickWithButton(ctx context.Context, button MouseButton, count values.Int) error
+
+		DragTo(ctx context.Context, target HTMLElement) error
+
+		Wheel(ctx context.Context, deltaX, deltaY values.Float) error
+
+		Hold(ctx context.Context, duration values.Int) error
+
+		Tap(ctx context.Context) error
+
+		Swipe(ctx context.Context, deltaX, deltaY values.Float) error
+
+		Pinch(ctx context.Context, scale values.Float) error
+
 		Clear(ctx context.Context) error
 
 		ClearBySelector(ctx context.Context, selector QuerySelector) error
@@ -112,6 +126,10 @@ type (
 
 		InputBySelector(ctx context.Context, selector QuerySelector, value core.Value, delay values.Int) error
 
+		SetFiles(ctx context.Context, files *values.Array) error
+
+		SetFilesBySelector(ctx context.Context, selector QuerySelector, files *values.Array) error
+
 		Press(ctx context.Context, keys []values.String, count values.Int) error
 
 		PressBySelector(ctx context.Context, selector QuerySelector, keys []values.String, count values.Int) error
@@ -155,6 +173,12 @@ type (
 		WaitForClassBySelector(ctx context.Context, selector QuerySelector, class values.String, when WaitEvent) error
 
 		WaitForClassBySelectorAll(ctx context.Context, selector QuerySelector, class values.String, when WaitEvent) error
+
+		Evaluate(ctx context.Context, expression values.String, args ...core.Value) (core.Value, error)
+
+		CaptureScreenshot(ctx context.Context, params ScreenshotParams) (values.Binary, error)
+
+		GetAccessibilityTree(ctx context.Context, params AccessibilityParams) (*values.Object, error)
 	}
 
 	HTMLDocument interface {
@@ -181,6 +205,8 @@ type (
 		ScrollBySelector(ctx context.Context, selector QuerySelector, options ScrollOptions) error
 
 		MoveMouseByXY(ctx context.Context, x, y values.Float) error
+
+		Evaluate(ctx context.Context, expression values.String, args ...core.Value) (core.Value, error)
 	}
 
 	// HTMLPage interface represents any web page loaded in the browser
@@ -202,6 +228,10 @@ type (
 
 		GetFrames(ctx context.Context) (*values.Array, error)
 
+		GetPages(ctx context.Context) (*values.Array, error)
+
+		BringToFront(ctx context.Context) error
+
 		GetFrame(ctx context.Context, idx values.Int) (core.Value, error)
 
 		GetCookies(ctx context.Context) (*HTTPCookies, error)
@@ -210,12 +240,34 @@ type (
 
 		DeleteCookies(ctx context.Context, cookies *HTTPCookies) error
 
+		GetStorage(ctx context.Context, storageType StorageType) (*values.Object, error)
+
+		SetStorage(ctx context.Context, storageType StorageType, items *values.Object) error
+
+		ClearStorage(ctx context.Context, storageType StorageType) error
+
+		GetIndexedDBNames(ctx context.Context) (*values.Array, error)
+
+		GetIndexedDBItems(ctx context.Context, database, store values.String) (*values.Array, error)
+
+		Intercept(ctx context.Context, rules ...InterceptRule) error
+
+		GetHAR(ctx context.Context) (*values.Object, error)
+
 		GetResponse(ctx context.Context) (HTTPResponse, error)
 
 		PrintToPDF(ctx context.Context, params PDFParams) (values.Binary, error)
 
 		CaptureScreenshot(ctx context.Context, params ScreenshotParams) (values.Binary, error)
 
+		GetAccessibilityTree(ctx context.Context, params AccessibilityParams) (*values.Object, error)
+
+		GetMetrics(ctx context.Context) (*values.Object, error)
+
+		StartCoverage(ctx context.Context, detailed values.Boolean) error
+
+		StopCoverage(ctx context.Context) (*values.Array, error)
+
 		WaitForNavigation(ctx context.Context, targetURL values.String) error
 
 		WaitForFrameNavigation(ctx context.Context, frame HTMLDocument, targetURL values.String) error
@@ -225,6 +277,8 @@ type (
 		NavigateBack(ctx context.Context, skip values.Int) (values.Boolean, error)
 
 		NavigateForward(ctx context.Context, skip values.Int) (values.Boolean, error)
+
+		HandleDialog(ctx context.Context, accept values.Boolean, promptText values.String) error
 	}
 )
 
@@ -0,0 +1,12 @@
+package formatter
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/parser"
+)
+
+var (
+	ErrEmptyQuery      = errors.New("empty query")
+	ErrUnexpectedToken = parser.ErrUnexpectedToken
+)
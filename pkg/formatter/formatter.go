@@ -0,0 +1,72 @@
+package formatter
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/parser"
+)
+
+// Formatter pretty-prints FQL queries in a canonical way.
+// Comments are preserved, keywords are normalized to a single case
+// and nested blocks like FOR bodies and object literals are indented.
+type Formatter struct {
+	opts *Options
+}
+
+func New(setters ...Option) *Formatter {
+	return &Formatter{
+		opts: newOptions(setters),
+	}
+}
+
+func (f *Formatter) Format(query string) (out string, err error) {
+	if strings.TrimSpace(query) == "" {
+		return "", ErrEmptyQuery
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			// find out exactly what the error was and set err
+			switch x := r.(type) {
+			case string:
+				err = errors.New(x)
+			case error:
+				err = x
+			default:
+				err = errors.New("unknown panic")
+			}
+
+			out = ""
+		}
+	}()
+
+	p := parser.New(query)
+	p.AddErrorListener(parser.NewErrorListener())
+
+	v := newVisitor(p.TokenStream(), f.opts)
+
+	return p.Visit(v).(string), nil
+}
+
+func (f *Formatter) MustFormat(query string) string {
+	out, err := f.Format(query)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+// IsFormatted reports whether a given query is already formatted.
+func (f *Formatter) IsFormatted(query string) (bool, error) {
+	out, err := f.Format(query)
+
+	if err != nil {
+		return false, err
+	}
+
+	return out == query, nil
+}
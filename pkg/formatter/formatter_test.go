@@ -0,0 +1,131 @@
+package formatter_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/formatter"
+)
+
+func TestFormatter(t *testing.T) {
+	Convey("Should return an error for an empty query", t, func() {
+		_, err := formatter.New().Format("  ")
+
+		So(err, ShouldEqual, formatter.ErrEmptyQuery)
+	})
+
+	Convey("Should return an error for an invalid query", t, func() {
+		_, err := formatter.New().Format("LET i = RETURN i")
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should normalize keyword casing", t, func() {
+		f := formatter.New()
+
+		out, err := f.Format(`let i = none return i`)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, "LET i = NONE\nRETURN i\n")
+
+		out, err = formatter.New(formatter.WithKeywordCase(formatter.KeywordCaseLower)).Format(`LET i = NONE RETURN i`)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, "let i = none\nreturn i\n")
+	})
+
+	Convey("Should keep names as they are", t, func() {
+		out, err := formatter.New().Format(`let count = length([1,2]) return { filter: count }`)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, "LET count = length([1, 2])\nRETURN { filter: count }\n")
+	})
+
+	Convey("Should indent FOR bodies", t, func() {
+		out, err := formatter.New().Format(`
+			USE X::Y
+			for i, idx in [1, 2, 3] filter i > 1 sort i desc let j = (for k in 1..i return k * 2) return j
+		`)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, `USE X::Y
+
+FOR i, idx IN [1, 2, 3]
+    FILTER i > 1
+    SORT i DESC
+    LET j = (
+        FOR k IN 1..i
+            RETURN k * 2
+    )
+    RETURN j
+`)
+	})
+
+	Convey("Should break long object literals", t, func() {
+		out, err := formatter.New(formatter.WithMaxWidth(40)).Format(`
+			LET doc = DOCUMENT(@url, { driver: "cdp", userAgent: "Ferret" })
+			RETURN { url: doc.url, title: doc.title, ok: doc ?: FALSE }
+		`)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, `LET doc = DOCUMENT(@url, {
+    driver: "cdp",
+    userAgent: "Ferret"
+})
+RETURN {
+    url: doc.url,
+    title: doc.title,
+    ok: doc ?: FALSE
+}
+`)
+	})
+
+	Convey("Should preserve comments and blank lines", t, func() {
+		out, err := formatter.New(formatter.WithTabs()).Format(`
+// header
+LET i = 1 // trailing
+
+
+/* block */
+FOR x IN [i]
+	// inside
+	RETURN x
+// footer`)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, `// header
+LET i = 1 // trailing
+
+/* block */
+FOR x IN [i]
+	// inside
+	RETURN x
+// footer
+`)
+	})
+
+	Convey("Should produce a stable output", t, func() {
+		f := formatter.New()
+		query := `
+			LET doc = DOCUMENT("https://www.google.com", { driver: "cdp" })
+			WAITFOR EVENT "navigation" IN doc OPTIONS { target: "x" } FILTER CURRENT.url != NONE TIMEOUT 1000
+			FOR el IN ELEMENTS(doc, ".result") COLLECT title = el.title INTO g KEEP el
+				RETURN { title, count: LENGTH(g), first: g[0]?.el.url }
+		`
+
+		out, err := f.Format(query)
+
+		So(err, ShouldBeNil)
+
+		formatted, err := f.IsFormatted(out)
+
+		So(err, ShouldBeNil)
+		So(formatted, ShouldBeTrue)
+
+		formatted, err = f.IsFormatted(query)
+
+		So(err, ShouldBeNil)
+		So(formatted, ShouldBeFalse)
+	})
+}
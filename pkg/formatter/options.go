@@ -0,0 +1,64 @@
+package formatter
+
+type (
+	KeywordCase int
+
+	Option  func(opts *Options)
+	Options struct {
+		indent      string
+		maxWidth    int
+		keywordCase KeywordCase
+	}
+)
+
+const (
+	KeywordCaseUpper KeywordCase = iota
+	KeywordCaseLower
+	KeywordCasePreserve
+)
+
+const (
+	DefaultIndent   = "    "
+	DefaultMaxWidth = 80
+)
+
+func newOptions(setters []Option) *Options {
+	opts := &Options{
+		indent:      DefaultIndent,
+		maxWidth:    DefaultMaxWidth,
+		keywordCase: KeywordCaseUpper,
+	}
+
+	for _, setter := range setters {
+		setter(opts)
+	}
+
+	return opts
+}
+
+// WithIndent sets a string used for a single level of indentation.
+func WithIndent(indent string) Option {
+	return func(opts *Options) {
+		opts.indent = indent
+	}
+}
+
+// WithTabs makes the formatter indent code with tabs.
+func WithTabs() Option {
+	return WithIndent("\t")
+}
+
+// WithMaxWidth sets a preferred line width.
+// Object literals, array literals and argument lists that do not fit into it are broken into multiple lines.
+func WithMaxWidth(width int) Option {
+	return func(opts *Options) {
+		opts.maxWidth = width
+	}
+}
+
+// WithKeywordCase sets a case keywords are normalized to.
+func WithKeywordCase(c KeywordCase) Option {
+	return func(opts *Options) {
+		opts.keywordCase = c
+	}
+}
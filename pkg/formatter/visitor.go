@@ -0,0 +1,679 @@
+package formatter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	"github.com/MontFerret/ferret/pkg/parser"
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+type visitor struct {
+	*fql.BaseFqlParserVisitor
+	opts      *Options
+	stream    *antlr.CommonTokenStream
+	out       *strings.Builder
+	depth     int
+	column    int
+	lineStart bool
+	space     bool
+	lastLine  int
+	lastToken int
+	consumed  int
+	flat      bool
+	broken    bool
+}
+
+var keywords = map[int]bool{
+	fql.FqlLexerAnd:            true,
+	fql.FqlLexerOr:             true,
+	fql.FqlLexerFor:            true,
+	fql.FqlLexerReturn:         true,
+	fql.FqlLexerWaitfor:        true,
+	fql.FqlLexerOptions:        true,
+	fql.FqlLexerTimeout:        true,
+	fql.FqlLexerDistinct:       true,
+	fql.FqlLexerFilter:         true,
+	fql.FqlLexerCurrent:        true,
+	fql.FqlLexerSort:           true,
+	fql.FqlLexerLimit:          true,
+	fql.FqlLexerLet:            true,
+	fql.FqlLexerCollect:        true,
+	fql.FqlLexerSortDirection:  true,
+	fql.FqlLexerNone:           true,
+	fql.FqlLexerNull:           true,
+	fql.FqlLexerBooleanLiteral: true,
+	fql.FqlLexerUse:            true,
+	fql.FqlLexerInto:           true,
+	fql.FqlLexerKeep:           true,
+	fql.FqlLexerWith:           true,
+	fql.FqlLexerCount:          true,
+	fql.FqlLexerAll:            true,
+	fql.FqlLexerAny:            true,
+	fql.FqlLexerAggregate:      true,
+	fql.FqlLexerEvent:          true,
+	fql.FqlLexerLike:           true,
+	fql.FqlLexerNot:            true,
+	fql.FqlLexerIn:             true,
+	fql.FqlLexerDo:             true,
+	fql.FqlLexerWhile:          true,
+}
+
+func newVisitor(stream *antlr.CommonTokenStream, opts *Options) *visitor {
+	return &visitor{
+		BaseFqlParserVisitor: &fql.BaseFqlParserVisitor{},
+		opts:                 opts,
+		stream:               stream,
+		out:                  &strings.Builder{},
+		lineStart:            true,
+		lastToken:            -1,
+		consumed:             -1,
+	}
+}
+
+func (v *visitor) VisitProgram(ctx *fql.ProgramContext) interface{} {
+	if err := parser.CheckEOF(v.stream); err != nil {
+		panic(err)
+	}
+
+	heads := ctx.AllHead()
+
+	for _, head := range heads {
+		v.newline()
+		v.visitNode(head)
+	}
+
+	if len(heads) > 0 {
+		v.blankLine()
+	}
+
+	v.visitNode(ctx.Body())
+
+	if stop := ctx.GetStop(); stop != nil && stop.GetTokenType() != antlr.TokenEOF {
+		v.comments(v.stream.GetHiddenTokensToRight(stop.GetTokenIndex(), antlr.TokenHiddenChannel), nil)
+	}
+
+	v.newline()
+
+	return v.out.String()
+}
+
+func (v *visitor) visitNode(node antlr.Tree) {
+	switch ctx := node.(type) {
+	case antlr.TerminalNode:
+		v.token(ctx)
+	case *fql.BodyContext:
+		v.visitStatements(ctx.GetChildren())
+	case *fql.ForExpressionContext:
+		v.visitFor(ctx)
+	case *fql.ObjectLiteralContext:
+		children := ctx.GetChildren()
+
+		v.visitList(children[0], children[1:len(children)-1], children[len(children)-1], true, false)
+	case *fql.ArrayLiteralContext:
+		v.visitArray(ctx)
+	case *fql.FunctionCallContext:
+		v.visitFunctionCall(ctx)
+	case *fql.PropertyAssignmentContext:
+		v.visitPropertyAssignment(ctx)
+	case *fql.ExpressionContext:
+		v.visitExpression(ctx)
+	case *fql.ExpressionAtomContext:
+		v.visitExpressionAtom(ctx)
+	case *fql.FunctionCallExpressionContext,
+		*fql.MemberExpressionContext,
+		*fql.MemberExpressionPathContext,
+		*fql.ComputedPropertyNameContext,
+		*fql.RangeOperatorContext:
+		v.concat(node.GetChildren())
+	case *fql.VariableContext,
+		*fql.ParamContext,
+		*fql.FunctionNameContext,
+		*fql.PropertyNameContext,
+		*fql.NamespaceIdentifierContext,
+		*fql.NamespaceContext,
+		*fql.SafeReservedWordContext,
+		*fql.UnsafeReservedWordContext:
+		// names are case-sensitive, thus they are printed as is
+		v.verbatim(node)
+	default:
+		v.join(node.GetChildren())
+	}
+}
+
+// visitStatements prints each statement on its own line,
+// keeping a single empty line between statements that were separated in the source code.
+func (v *visitor) visitStatements(nodes []antlr.Tree) {
+	for i, node := range nodes {
+		if i > 0 {
+			v.newline()
+
+			if v.firstLine(node) > v.lastLine+1 {
+				v.blankLine()
+			}
+		}
+
+		v.visitNode(node)
+	}
+}
+
+func (v *visitor) visitFor(ctx *fql.ForExpressionContext) {
+	header := make([]antlr.Tree, 0, 6)
+	body := make([]antlr.Tree, 0, 5)
+
+	for _, child := range ctx.GetChildren() {
+		switch child.(type) {
+		case *fql.ForExpressionBodyContext, *fql.ForExpressionReturnContext:
+			body = append(body, child)
+		default:
+			header = append(header, child)
+		}
+	}
+
+	v.join(header)
+	v.depth++
+	v.newline()
+	v.visitStatements(body)
+	v.depth--
+}
+
+func (v *visitor) visitArray(ctx *fql.ArrayLiteralContext) {
+	var items []antlr.Tree
+
+	if args := ctx.ArgumentList(); args != nil {
+		items = args.GetChildren()
+	}
+
+	v.visitList(ctx.OpenBracket(), items, ctx.CloseBracket(), false, false)
+}
+
+func (v *visitor) visitFunctionCall(ctx *fql.FunctionCallContext) {
+	var args []antlr.Tree
+
+	if list := ctx.ArgumentList(); list != nil {
+		args = list.GetChildren()
+	}
+
+	v.verbatim(ctx.Namespace())
+	v.verbatim(ctx.FunctionName())
+	v.visitList(ctx.OpenParen(), args, ctx.CloseParen(), false, true)
+}
+
+func (v *visitor) visitPropertyAssignment(ctx *fql.PropertyAssignmentContext) {
+	children := ctx.GetChildren()
+
+	if len(children) == 1 {
+		v.visitNode(children[0])
+
+		return
+	}
+
+	v.visitNode(children[0])
+	v.visitNode(children[1])
+	v.sep()
+	v.visitNode(children[2])
+}
+
+func (v *visitor) visitExpression(ctx *fql.ExpressionContext) {
+	children := ctx.GetChildren()
+
+	if op, ok := children[0].(*fql.UnaryOperatorContext); ok {
+		v.visitNode(op)
+
+		text := op.GetText()
+		right := ctx.GetRight().GetText()
+
+		// NOT requires a space, while "- -1" must not turn into a decrement
+		if isWord(text) || strings.HasPrefix(right, text) {
+			v.sep()
+		}
+
+		v.visitNode(ctx.GetRight())
+
+		return
+	}
+
+	for i, child := range children {
+		// Elvis operator "?:"
+		if i > 0 && !(isTerminal(child, fql.FqlLexerColon) && isTerminal(children[i-1], fql.FqlLexerQuestionMark)) {
+			v.sep()
+		}
+
+		v.visitNode(child)
+	}
+}
+
+func (v *visitor) visitExpressionAtom(ctx *fql.ExpressionAtomContext) {
+	if ctx.OpenParen() == nil {
+		v.join(ctx.GetChildren())
+
+		return
+	}
+
+	v.token(ctx.OpenParen())
+
+	if f := ctx.ForExpression(); f != nil {
+		v.depth++
+		v.newline()
+		v.visitNode(f)
+		v.depth--
+		v.newline()
+	} else if w := ctx.WaitForExpression(); w != nil {
+		v.visitNode(w)
+	} else {
+		v.visitNode(ctx.Expression())
+	}
+
+	v.token(ctx.CloseParen())
+
+	if e := ctx.ErrorOperator(); e != nil {
+		v.visitNode(e)
+	}
+}
+
+// visitList prints a comma separated list of items surrounded by the open and close tokens.
+// The list is printed on a single line if it fits, otherwise each item is printed on its own line.
+// If hug is true, the last item, being an object or array literal, may keep the list on the same line.
+func (v *visitor) visitList(open antlr.Tree, items []antlr.Tree, close antlr.Tree, padding, hug bool) {
+	v.visitNode(open)
+
+	if len(items) == 0 {
+		v.visitNode(close)
+
+		return
+	}
+
+	flat := v.flat || v.fits(func(f *visitor) {
+		f.visitFlatList(items, padding)
+		f.visitNode(close)
+	})
+
+	if flat {
+		v.visitFlatList(items, padding)
+		v.visitNode(close)
+
+		return
+	}
+
+	last := len(items) - 1
+
+	for last > 0 && isTerminal(items[last], fql.FqlLexerComma) {
+		last--
+	}
+
+	if hug && isLiteral(items[last]) {
+		prefix := func(v *visitor) {
+			if last > 0 {
+				v.visitFlatList(items[:last-1], false)
+				v.visitNode(items[last-1])
+				v.sep()
+			}
+		}
+
+		fits := v.fits(func(f *visitor) {
+			prefix(f)
+			f.visitNode(open)
+		})
+
+		if fits {
+			prefix(v)
+			v.visitNode(items[last])
+			v.flushComments(items[len(items)-1])
+			v.visitNode(close)
+
+			return
+		}
+	}
+
+	v.depth++
+
+	for i, item := range items {
+		if isTerminal(item, fql.FqlLexerComma) {
+			if i > last {
+				// trailing commas are dropped
+				v.flushComments(item)
+			} else {
+				v.visitNode(item)
+			}
+
+			continue
+		}
+
+		v.newline()
+		v.visitNode(item)
+	}
+
+	v.flushComments(close)
+	v.depth--
+	v.newline()
+	v.visitNode(close)
+}
+
+func (v *visitor) visitFlatList(items []antlr.Tree, padding bool) {
+	if padding {
+		v.sep()
+	}
+
+	for i, item := range items {
+		if isTerminal(item, fql.FqlLexerComma) {
+			if i == len(items)-1 {
+				v.flushComments(item)
+			} else {
+				v.visitNode(item)
+				v.sep()
+			}
+
+			continue
+		}
+
+		v.visitNode(item)
+	}
+
+	if padding {
+		v.sep()
+	}
+}
+
+// join prints nodes separated by spaces.
+func (v *visitor) join(nodes []antlr.Tree) {
+	for i, node := range nodes {
+		if i > 0 && !isTerminal(node, fql.FqlLexerComma) {
+			v.sep()
+		}
+
+		v.visitNode(node)
+	}
+}
+
+// concat prints nodes without any separators.
+func (v *visitor) concat(nodes []antlr.Tree) {
+	for _, node := range nodes {
+		v.visitNode(node)
+	}
+}
+
+// verbatim prints all tokens of a given node as they are in the source code.
+func (v *visitor) verbatim(node antlr.Tree) {
+	if node == nil {
+		return
+	}
+
+	if t, ok := node.(antlr.TerminalNode); ok {
+		v.symbol(t.GetSymbol(), t.GetText())
+
+		return
+	}
+
+	for _, child := range node.GetChildren() {
+		v.verbatim(child)
+	}
+}
+
+func (v *visitor) token(node antlr.TerminalNode) {
+	sym := node.GetSymbol()
+	text := node.GetText()
+
+	if keywords[sym.GetTokenType()] && isWord(text) {
+		switch v.opts.keywordCase {
+		case KeywordCaseUpper:
+			text = strings.ToUpper(text)
+		case KeywordCaseLower:
+			text = strings.ToLower(text)
+		}
+	}
+
+	v.symbol(sym, text)
+}
+
+func (v *visitor) symbol(sym antlr.Token, text string) {
+	v.comments(v.stream.GetHiddenTokensToLeft(sym.GetTokenIndex(), antlr.TokenHiddenChannel), sym)
+	v.write(text)
+	v.lastLine = sym.GetLine() + strings.Count(text, "\n")
+	v.lastToken = sym.GetTokenIndex()
+}
+
+// flushComments prints comments preceding a given token without printing the token itself.
+func (v *visitor) flushComments(node antlr.Tree) {
+	if t, ok := node.(antlr.TerminalNode); ok {
+		sym := t.GetSymbol()
+
+		v.comments(v.stream.GetHiddenTokensToLeft(sym.GetTokenIndex(), antlr.TokenHiddenChannel), sym)
+	}
+}
+
+// comments prints comments from a given list of hidden tokens.
+// Comments placed on the same line with the previous token stay there,
+// other ones are printed on their own lines.
+func (v *visitor) comments(hidden []antlr.Token, next antlr.Token) {
+	list := make([]antlr.Token, 0, len(hidden))
+
+	for _, t := range hidden {
+		if t.GetTokenIndex() <= v.consumed {
+			continue
+		}
+
+		switch t.GetTokenType() {
+		case fql.FqlLexerSingleLineComment, fql.FqlLexerMultiLineComment:
+			list = append(list, t)
+		}
+	}
+
+	if len(list) == 0 {
+		return
+	}
+
+	if v.flat {
+		v.broken = true
+
+		return
+	}
+
+	for i, t := range list {
+		v.consumed = t.GetTokenIndex()
+		text := strings.TrimRightFunc(t.GetText(), unicode.IsSpace)
+
+		if v.lineStart || t.GetLine() != v.lastLine {
+			v.newline()
+
+			if v.lastLine > 0 && t.GetLine() > v.lastLine+1 {
+				v.blankLine()
+			}
+		} else {
+			v.sep()
+		}
+
+		v.write(text)
+		v.lastLine = t.GetLine() + strings.Count(text, "\n")
+
+		nextLine := -1
+
+		if i+1 < len(list) {
+			nextLine = list[i+1].GetLine()
+		} else if next != nil {
+			nextLine = next.GetLine()
+		}
+
+		switch {
+		case t.GetTokenType() == fql.FqlLexerSingleLineComment || nextLine > v.lastLine:
+			v.newline()
+
+			if nextLine > v.lastLine+1 {
+				v.blankLine()
+			}
+		default:
+			v.sep()
+		}
+	}
+}
+
+// trailingComments prints comments following the last printed token on the same line.
+func (v *visitor) trailingComments() {
+	if v.lastToken < 0 {
+		return
+	}
+
+	for _, t := range v.stream.GetHiddenTokensToRight(v.lastToken, antlr.TokenHiddenChannel) {
+		if t.GetTokenIndex() <= v.consumed || t.GetLine() != v.lastLine {
+			continue
+		}
+
+		switch t.GetTokenType() {
+		case fql.FqlLexerSingleLineComment, fql.FqlLexerMultiLineComment:
+			v.consumed = t.GetTokenIndex()
+			v.sep()
+			v.write(strings.TrimRightFunc(t.GetText(), unicode.IsSpace))
+		}
+	}
+}
+
+// firstLine returns a line where a given node starts, including its leading comments.
+func (v *visitor) firstLine(node antlr.Tree) int {
+	ctx, ok := node.(antlr.ParserRuleContext)
+
+	if !ok {
+		return v.lastLine
+	}
+
+	start := ctx.GetStart()
+
+	for _, t := range v.stream.GetHiddenTokensToLeft(start.GetTokenIndex(), antlr.TokenHiddenChannel) {
+		if t.GetTokenIndex() <= v.consumed {
+			continue
+		}
+
+		switch t.GetTokenType() {
+		case fql.FqlLexerSingleLineComment, fql.FqlLexerMultiLineComment:
+			return t.GetLine()
+		}
+	}
+
+	return start.GetLine()
+}
+
+// fits reports whether the output of a given function fits into the current line.
+func (v *visitor) fits(fn func(f *visitor)) bool {
+	f := &visitor{
+		BaseFqlParserVisitor: v.BaseFqlParserVisitor,
+		opts:                 v.opts,
+		stream:               v.stream,
+		out:                  &strings.Builder{},
+		lastLine:             v.lastLine,
+		consumed:             v.consumed,
+		flat:                 true,
+	}
+
+	fn(f)
+
+	if f.broken {
+		return false
+	}
+
+	start := v.column
+
+	if v.lineStart {
+		start = v.depth * utf8.RuneCountInString(v.opts.indent)
+	} else if v.space {
+		start++
+	}
+
+	return start+utf8.RuneCountInString(f.out.String()) <= v.opts.maxWidth
+}
+
+func (v *visitor) write(s string) {
+	if v.lineStart {
+		v.out.WriteString(strings.Repeat(v.opts.indent, v.depth))
+		v.column = v.depth * utf8.RuneCountInString(v.opts.indent)
+		v.lineStart = false
+	} else if v.space {
+		v.out.WriteByte(' ')
+		v.column++
+	}
+
+	v.space = false
+	v.out.WriteString(s)
+
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		// multi-line string literals can not be printed on a single line
+		v.broken = v.broken || v.flat
+		v.column = utf8.RuneCountInString(s[i+1:])
+	} else {
+		v.column += utf8.RuneCountInString(s)
+	}
+}
+
+func (v *visitor) sep() {
+	v.space = true
+}
+
+func (v *visitor) newline() {
+	if v.flat {
+		v.broken = true
+		v.space = true
+
+		return
+	}
+
+	v.space = false
+
+	if v.lineStart {
+		return
+	}
+
+	v.trailingComments()
+	v.out.WriteByte('\n')
+	v.column = 0
+	v.lineStart = true
+}
+
+func (v *visitor) blankLine() {
+	v.newline()
+
+	if v.flat {
+		return
+	}
+
+	out := v.out.String()
+
+	if len(out) > 0 && !strings.HasSuffix(out, "\n\n") {
+		v.out.WriteByte('\n')
+	}
+}
+
+func isTerminal(node antlr.Tree, tokenType int) bool {
+	t, ok := node.(antlr.TerminalNode)
+
+	return ok && t.GetSymbol().GetTokenType() == tokenType
+}
+
+// isLiteral reports whether a given expression is an object or array literal.
+func isLiteral(node antlr.Tree) bool {
+	for {
+		children := node.GetChildren()
+
+		switch node.(type) {
+		case *fql.ObjectLiteralContext, *fql.ArrayLiteralContext:
+			return true
+		case *fql.ExpressionContext, *fql.PredicateContext, *fql.ExpressionAtomContext, *fql.LiteralContext:
+			if len(children) != 1 {
+				return false
+			}
+
+			node = children[0]
+		default:
+			return false
+		}
+	}
+}
+
+func isWord(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+
+	return len(s) > 0
+}
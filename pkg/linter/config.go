@@ -0,0 +1,99 @@
+package linter
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Arguments holds rule specific settings.
+	Arguments map[string]interface{}
+
+	RuleConfig struct {
+		Disabled  bool      `json:"disabled,omitempty" yaml:"disabled,omitempty"`
+		Severity  Severity  `json:"severity,omitempty" yaml:"severity,omitempty"`
+		Arguments Arguments `json:"arguments,omitempty" yaml:"arguments,omitempty"`
+	}
+
+	Config struct {
+		// Severity is used for rules that do not define their own one.
+		Severity Severity              `json:"severity,omitempty" yaml:"severity,omitempty"`
+		Rules    map[string]RuleConfig `json:"rules,omitempty" yaml:"rules,omitempty"`
+	}
+)
+
+// LoadConfig reads a JSON encoded configuration.
+func LoadConfig(r io.Reader) (Config, error) {
+	var cfg Config
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&cfg); err != nil {
+		return cfg, errors.Wrap(err, "decode linter config")
+	}
+
+	return cfg, nil
+}
+
+// Strings returns a list of strings stored by a given name or the default value if it is not set.
+func (args Arguments) Strings(name string, defaults []string) ([]string, error) {
+	value, found := args[name]
+
+	if !found {
+		return defaults, nil
+	}
+
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []interface{}:
+		out := make([]string, 0, len(v))
+
+		for _, item := range v {
+			str, ok := item.(string)
+
+			if !ok {
+				return nil, errors.Wrapf(ErrInvalidArgument, "%s: expected a list of strings", name)
+			}
+
+			out = append(out, str)
+		}
+
+		return out, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidArgument, "%s: expected a list of strings", name)
+	}
+}
+
+// StringMap returns a map of strings stored by a given name or the default value if it is not set.
+func (args Arguments) StringMap(name string, defaults map[string]string) (map[string]string, error) {
+	value, found := args[name]
+
+	if !found {
+		return defaults, nil
+	}
+
+	switch v := value.(type) {
+	case map[string]string:
+		return v, nil
+	case map[string]interface{}:
+		out := make(map[string]string, len(v))
+
+		for key, item := range v {
+			str, ok := item.(string)
+
+			if !ok {
+				return nil, errors.Wrapf(ErrInvalidArgument, "%s: expected a map of strings", name)
+			}
+
+			out[key] = str
+		}
+
+		return out, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidArgument, "%s: expected a map of strings", name)
+	}
+}
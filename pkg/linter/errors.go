@@ -0,0 +1,14 @@
+package linter
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/parser"
+)
+
+var (
+	ErrEmptyQuery      = errors.New("empty query")
+	ErrUnexpectedToken = parser.ErrUnexpectedToken
+	ErrUnknownRule     = errors.New("unknown rule")
+	ErrInvalidArgument = errors.New("invalid argument")
+)
@@ -0,0 +1,40 @@
+package linter
+
+import (
+	"fmt"
+)
+
+type (
+	Severity string
+
+	Position struct {
+		Filename string `json:"filename,omitempty"`
+		Line     int    `json:"line"`
+		Column   int    `json:"column"`
+	}
+
+	// Failure represents a single problem found by a rule.
+	Failure struct {
+		Rule     string   `json:"rule"`
+		Severity Severity `json:"severity"`
+		Message  string   `json:"message"`
+		Position Position `json:"position"`
+	}
+)
+
+const (
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+func (p Position) String() string {
+	if p.Filename != "" {
+		return fmt.Sprintf("%s:%d:%d", p.Filename, p.Line, p.Column)
+	}
+
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+func (f Failure) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Position, f.Severity, f.Message, f.Rule)
+}
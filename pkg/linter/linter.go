@@ -0,0 +1,167 @@
+package linter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/parser"
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+// Linter checks FQL queries against a set of rules.
+type Linter struct {
+	rules  []Rule
+	config Config
+}
+
+type programVisitor struct {
+	*fql.BaseFqlParserVisitor
+	stream *antlr.CommonTokenStream
+}
+
+func New(setters ...Option) *Linter {
+	opts := &Options{}
+
+	for _, setter := range setters {
+		setter(opts)
+	}
+
+	l := &Linter{
+		rules:  append(DefaultRules(), opts.rules...),
+		config: opts.config,
+	}
+
+	if l.config.Severity == "" {
+		l.config.Severity = SeverityWarning
+	}
+
+	return l
+}
+
+// Rules returns all registered rules.
+func (l *Linter) Rules() []Rule {
+	return l.rules[:]
+}
+
+func (l *Linter) Lint(query string) ([]Failure, error) {
+	return l.LintFile("", query)
+}
+
+// LintFile checks a given query and reports failures with a given file name.
+func (l *Linter) LintFile(filename, query string) (failures []Failure, err error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	if err := l.validateConfig(); err != nil {
+		return nil, err
+	}
+
+	tree, err := l.parse(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		Name:   filename,
+		Source: query,
+		Tree:   tree,
+	}
+
+	failures = make([]Failure, 0, 10)
+
+	for _, rule := range l.rules {
+		cfg := l.config.Rules[rule.Name()]
+
+		if cfg.Disabled {
+			continue
+		}
+
+		found, err := rule.Apply(file, cfg.Arguments)
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule %s", rule.Name())
+		}
+
+		severity := cfg.Severity
+
+		if severity == "" {
+			severity = l.config.Severity
+		}
+
+		for _, f := range found {
+			f.Severity = severity
+			failures = append(failures, f)
+		}
+	}
+
+	sort.SliceStable(failures, func(i, j int) bool {
+		a, b := failures[i].Position, failures[j].Position
+
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+
+		return a.Column < b.Column
+	})
+
+	return failures, nil
+}
+
+func (l *Linter) validateConfig() error {
+	for name := range l.config.Rules {
+		found := false
+
+		for _, rule := range l.rules {
+			if rule.Name() == name {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return errors.Wrap(ErrUnknownRule, name)
+		}
+	}
+
+	return nil
+}
+
+func (l *Linter) parse(query string) (tree *fql.ProgramContext, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			// find out exactly what the error was and set err
+			switch x := r.(type) {
+			case string:
+				err = errors.New(x)
+			case error:
+				err = x
+			default:
+				err = errors.New("unknown panic")
+			}
+
+			tree = nil
+		}
+	}()
+
+	p := parser.New(query)
+	p.AddErrorListener(parser.NewErrorListener())
+
+	return p.Visit(&programVisitor{
+		BaseFqlParserVisitor: &fql.BaseFqlParserVisitor{},
+		stream:               p.TokenStream(),
+	}).(*fql.ProgramContext), nil
+}
+
+func (v *programVisitor) VisitProgram(ctx *fql.ProgramContext) interface{} {
+	if err := parser.CheckEOF(v.stream); err != nil {
+		panic(err)
+	}
+
+	return ctx
+}
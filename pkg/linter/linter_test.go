@@ -0,0 +1,235 @@
+package linter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/linter"
+	"github.com/MontFerret/ferret/pkg/stdlib"
+)
+
+func rulesOf(failures []linter.Failure) []string {
+	out := make([]string, 0, len(failures))
+
+	for _, f := range failures {
+		out = append(out, f.Rule)
+	}
+
+	return out
+}
+
+func TestLinter(t *testing.T) {
+	Convey("Should return an error for an invalid query", t, func() {
+		_, err := linter.New().Lint("RETURN")
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should not report anything for a clean query", t, func() {
+		failures, err := linter.New().Lint(`
+			LET doc = DOCUMENT(@url, { driver: "cdp" })
+			WAITFOR EVENT "navigation" IN doc TIMEOUT 5000
+			FOR i WHILE ELEMENT_EXISTS(doc, ".next")
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+		So(failures, ShouldBeEmpty)
+	})
+
+	Convey("Should report DOCUMENT calls inside loops", t, func() {
+		failures, err := linter.New().Lint(`
+			FOR url IN @urls
+				LET doc = DOCUMENT(url)
+				RETURN doc.title
+		`)
+
+		So(err, ShouldBeNil)
+		So(rulesOf(failures), ShouldResemble, []string{"document-in-loop"})
+		So(failures[0].Position.Line, ShouldEqual, 3)
+		So(failures[0].Position.Column, ShouldEqual, 15)
+		So(failures[0].Severity, ShouldEqual, linter.SeverityWarning)
+
+		l := linter.New(linter.WithConfig(linter.Config{
+			Rules: map[string]linter.RuleConfig{
+				"document-in-loop": {
+					Arguments: linter.Arguments{
+						"closeFunctions": []interface{}{"CLOSE"},
+					},
+				},
+			},
+		}))
+
+		failures, err = l.Lint(`
+			FOR url IN @urls
+				LET doc = DOCUMENT(url)
+				LET title = doc.title
+				CLOSE(doc)
+				RETURN title
+		`)
+
+		So(err, ShouldBeNil)
+		So(failures, ShouldBeEmpty)
+	})
+
+	Convey("Should report WAITFOR without TIMEOUT", t, func() {
+		failures, err := linter.New().Lint(`
+			LET doc = DOCUMENT(@url, { driver: "cdp" })
+			WAITFOR EVENT "navigation" IN doc
+			RETURN doc
+		`)
+
+		So(err, ShouldBeNil)
+		So(rulesOf(failures), ShouldResemble, []string{"waitfor-timeout"})
+	})
+
+	Convey("Should report hard-coded sleeps", t, func() {
+		failures, err := linter.New().Lint(`
+			WAIT(1000)
+			WAIT(@timeout)
+			RETURN NONE
+		`)
+
+		So(err, ShouldBeNil)
+		So(rulesOf(failures), ShouldResemble, []string{"hardcoded-sleep"})
+		So(failures[0].Position.Line, ShouldEqual, 2)
+	})
+
+	Convey("Should report unbounded WHILE loops", t, func() {
+		failures, err := linter.New().Lint(`
+			FOR i WHILE TRUE
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+		So(rulesOf(failures), ShouldResemble, []string{"unbounded-while"})
+
+		failures, err = linter.New().Lint(`
+			FOR i WHILE TRUE
+				LIMIT 10
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+		So(failures, ShouldBeEmpty)
+	})
+
+	Convey("Should report deprecated functions", t, func() {
+		l := linter.New(linter.WithConfig(linter.Config{
+			Rules: map[string]linter.RuleConfig{
+				"deprecated-function": {
+					Severity: linter.SeverityError,
+					Arguments: linter.Arguments{
+						"functions": map[string]interface{}{
+							"log": "PRINT",
+						},
+					},
+				},
+			},
+		}))
+
+		failures, err := l.Lint(`
+			LOG("foo")
+			RETURN NONE
+		`)
+
+		So(err, ShouldBeNil)
+		So(failures, ShouldHaveLength, 1)
+		So(failures[0].Severity, ShouldEqual, linter.SeverityError)
+		So(failures[0].Message, ShouldEqual, "LOG is deprecated, use PRINT instead")
+	})
+
+	Convey("Should report deprecated functions of the standard library by default", t, func() {
+		stdlib.DeprecatedFunctions["OLD_FN"] = "NEW_FN"
+		defer delete(stdlib.DeprecatedFunctions, "OLD_FN")
+
+		failures, err := linter.New().Lint(`
+			OLD_FN("foo")
+			RETURN NONE
+		`)
+
+		So(err, ShouldBeNil)
+		So(failures, ShouldHaveLength, 1)
+		So(failures[0].Message, ShouldEqual, "OLD_FN is deprecated, use NEW_FN instead")
+
+		l := linter.New(linter.WithConfig(linter.Config{
+			Rules: map[string]linter.RuleConfig{
+				"deprecated-function": {
+					Arguments: linter.Arguments{
+						"functions": map[string]interface{}{
+							"old_fn": "",
+						},
+					},
+				},
+			},
+		}))
+
+		failures, err = l.Lint(`
+			OLD_FN("foo")
+			RETURN NONE
+		`)
+
+		So(err, ShouldBeNil)
+		So(failures, ShouldHaveLength, 1)
+		So(failures[0].Message, ShouldEqual, "OLD_FN is deprecated")
+	})
+
+	Convey("Should disable rules", t, func() {
+		cfg, err := linter.LoadConfig(strings.NewReader(`{
+			"rules": {
+				"hardcoded-sleep": { "disabled": true }
+			}
+		}`))
+
+		So(err, ShouldBeNil)
+
+		failures, err := linter.New(linter.WithConfig(cfg)).Lint(`
+			WAIT(1000)
+			RETURN NONE
+		`)
+
+		So(err, ShouldBeNil)
+		So(failures, ShouldBeEmpty)
+	})
+
+	Convey("Should fail on unknown rules", t, func() {
+		l := linter.New(linter.WithConfig(linter.Config{
+			Rules: map[string]linter.RuleConfig{
+				"foo": {},
+			},
+		}))
+
+		_, err := l.Lint(`RETURN NONE`)
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should write failures as JSON", t, func() {
+		failures, err := linter.New().LintFile("query.fql", `
+			WAIT(1000)
+			RETURN NONE
+		`)
+
+		So(err, ShouldBeNil)
+
+		buff := &bytes.Buffer{}
+
+		So(linter.WriteJSON(buff, failures), ShouldBeNil)
+
+		var out []map[string]interface{}
+
+		So(json.Unmarshal(buff.Bytes(), &out), ShouldBeNil)
+		So(out, ShouldHaveLength, 1)
+		So(out[0]["rule"], ShouldEqual, "hardcoded-sleep")
+		So(out[0]["position"].(map[string]interface{})["filename"], ShouldEqual, "query.fql")
+
+		buff.Reset()
+
+		So(linter.WriteText(buff, failures), ShouldBeNil)
+		So(buff.String(), ShouldStartWith, "query.fql:2:4: warning:")
+	})
+}
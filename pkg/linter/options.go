@@ -0,0 +1,23 @@
+package linter
+
+type (
+	Option  func(opts *Options)
+	Options struct {
+		config Config
+		rules  []Rule
+	}
+)
+
+// WithConfig sets a configuration that enables, disables and tunes rules.
+func WithConfig(config Config) Option {
+	return func(opts *Options) {
+		opts.config = config
+	}
+}
+
+// WithRules adds custom rules to the builtin ones.
+func WithRules(rules ...Rule) Option {
+	return func(opts *Options) {
+		opts.rules = append(opts.rules, rules...)
+	}
+}
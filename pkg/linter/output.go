@@ -0,0 +1,27 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteText writes failures in a human readable form, one per line.
+func WriteText(w io.Writer, failures []Failure) error {
+	for _, f := range failures {
+		if _, err := fmt.Fprintln(w, f.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes failures as a JSON array.
+func WriteJSON(w io.Writer, failures []Failure) error {
+	if failures == nil {
+		failures = []Failure{}
+	}
+
+	return json.NewEncoder(w).Encode(failures)
+}
@@ -0,0 +1,70 @@
+package linter
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+type (
+	// File is a parsed query passed to rules.
+	File struct {
+		Name   string
+		Source string
+		Tree   *fql.ProgramContext
+	}
+
+	// Rule checks a parsed query and reports found problems.
+	// Severity of the returned failures is set by the linter.
+	Rule interface {
+		Name() string
+		Apply(file *File, args Arguments) ([]Failure, error)
+	}
+)
+
+// DefaultRules returns all builtin rules.
+func DefaultRules() []Rule {
+	return []Rule{
+		&DocumentInLoopRule{},
+		&WaitforTimeoutRule{},
+		&HardcodedSleepRule{},
+		&UnboundedWhileRule{},
+		&DeprecatedFunctionRule{},
+	}
+}
+
+func (f *File) Walk(listener fql.FqlParserListener) {
+	antlr.ParseTreeWalkerDefault.Walk(listener, f.Tree)
+}
+
+// Failure creates a new failure pointing to the beginning of a given node.
+func (f *File) Failure(rule Rule, node antlr.ParserRuleContext, message string) Failure {
+	start := node.GetStart()
+
+	return Failure{
+		Rule:    rule.Name(),
+		Message: message,
+		Position: Position{
+			Filename: f.Name,
+			Line:     start.GetLine(),
+			Column:   start.GetColumn() + 1,
+		},
+	}
+}
+
+// functionName returns a fully qualified name of a called function in upper case.
+func functionName(ctx *fql.FunctionCallContext) string {
+	return strings.ToUpper(ctx.Namespace().GetText() + ctx.FunctionName().GetText())
+}
+
+func toSet(list []string) map[string]bool {
+	set := make(map[string]bool, len(list))
+
+	for _, item := range list {
+		set[strings.ToUpper(item)] = true
+	}
+
+	return set
+}
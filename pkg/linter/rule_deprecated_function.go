@@ -0,0 +1,80 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+	"github.com/MontFerret/ferret/pkg/stdlib"
+)
+
+type (
+	// DeprecatedFunctionRule reports calls of deprecated functions.
+	// Deprecated functions of the standard library are reported by default.
+	// Arguments:
+	//   functions - a map of deprecated function names to their replacements, overriding the defaults.
+	//   An empty replacement means that the function has no replacement.
+	DeprecatedFunctionRule struct{}
+
+	deprecatedFunctionListener struct {
+		*fql.BaseFqlParserListener
+		file       *File
+		rule       Rule
+		deprecated map[string]string
+		failures   []Failure
+	}
+)
+
+func (r *DeprecatedFunctionRule) Name() string {
+	return "deprecated-function"
+}
+
+func (r *DeprecatedFunctionRule) Apply(file *File, args Arguments) ([]Failure, error) {
+	fns, err := args.StringMap("functions", nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deprecated := make(map[string]string, len(stdlib.DeprecatedFunctions)+len(fns))
+
+	for name, replacement := range stdlib.DeprecatedFunctions {
+		deprecated[strings.ToUpper(name)] = replacement
+	}
+
+	for name, replacement := range fns {
+		deprecated[strings.ToUpper(name)] = replacement
+	}
+
+	if len(deprecated) == 0 {
+		return nil, nil
+	}
+
+	l := &deprecatedFunctionListener{
+		BaseFqlParserListener: &fql.BaseFqlParserListener{},
+		file:                  file,
+		rule:                  r,
+		deprecated:            deprecated,
+	}
+
+	file.Walk(l)
+
+	return l.failures, nil
+}
+
+func (l *deprecatedFunctionListener) EnterFunctionCall(ctx *fql.FunctionCallContext) {
+	name := functionName(ctx)
+	replacement, found := l.deprecated[name]
+
+	if !found {
+		return
+	}
+
+	msg := fmt.Sprintf("%s is deprecated", name)
+
+	if replacement != "" {
+		msg += fmt.Sprintf(", use %s instead", replacement)
+	}
+
+	l.failures = append(l.failures, l.file.Failure(l.rule, ctx, msg))
+}
@@ -0,0 +1,142 @@
+package linter
+
+import (
+	"fmt"
+
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+type (
+	// DocumentInLoopRule reports pages opened inside loops.
+	// Such pages stay alive until the query finishes unless they are explicitly closed.
+	// Arguments:
+	//   functions - names of functions that open pages. Default: ["DOCUMENT"].
+	//   closeFunctions - names of functions that close a page passed as the first argument.
+	DocumentInLoopRule struct{}
+
+	documentInLoopFrame struct {
+		opened []documentCall
+		closed map[string]bool
+	}
+
+	documentCall struct {
+		ctx      *fql.FunctionCallContext
+		variable string
+	}
+
+	documentInLoopListener struct {
+		*fql.BaseFqlParserListener
+		file     *File
+		rule     Rule
+		open     map[string]bool
+		close    map[string]bool
+		frames   []*documentInLoopFrame
+		failures []Failure
+	}
+)
+
+func (r *DocumentInLoopRule) Name() string {
+	return "document-in-loop"
+}
+
+func (r *DocumentInLoopRule) Apply(file *File, args Arguments) ([]Failure, error) {
+	open, err := args.Strings("functions", []string{"DOCUMENT"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	closeFns, err := args.Strings("closeFunctions", nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	l := &documentInLoopListener{
+		BaseFqlParserListener: &fql.BaseFqlParserListener{},
+		file:                  file,
+		rule:                  r,
+		open:                  toSet(open),
+		close:                 toSet(closeFns),
+	}
+
+	file.Walk(l)
+
+	return l.failures, nil
+}
+
+func (l *documentInLoopListener) EnterForExpression(_ *fql.ForExpressionContext) {
+	l.frames = append(l.frames, &documentInLoopFrame{
+		closed: make(map[string]bool),
+	})
+}
+
+func (l *documentInLoopListener) ExitForExpression(_ *fql.ForExpressionContext) {
+	frame := l.frames[len(l.frames)-1]
+	l.frames = l.frames[:len(l.frames)-1]
+
+	for _, call := range frame.opened {
+		if call.variable != "" && frame.closed[call.variable] {
+			continue
+		}
+
+		l.failures = append(l.failures, l.file.Failure(
+			l.rule,
+			call.ctx,
+			fmt.Sprintf("%s is called inside a loop without closing the page", functionName(call.ctx)),
+		))
+	}
+}
+
+func (l *documentInLoopListener) EnterFunctionCall(ctx *fql.FunctionCallContext) {
+	if len(l.frames) == 0 {
+		return
+	}
+
+	frame := l.frames[len(l.frames)-1]
+	name := functionName(ctx)
+
+	if l.open[name] {
+		frame.opened = append(frame.opened, documentCall{
+			ctx:      ctx,
+			variable: assignedVariable(ctx),
+		})
+	}
+
+	if l.close[name] {
+		if args := ctx.ArgumentList(); args != nil {
+			if list := args.(*fql.ArgumentListContext).AllExpression(); len(list) > 0 {
+				frame.closed[list[0].GetText()] = true
+			}
+		}
+	}
+}
+
+// assignedVariable returns a name of a variable the result of a given call is assigned to.
+func assignedVariable(ctx *fql.FunctionCallContext) string {
+	node := ctx.GetParent()
+
+	for node != nil {
+		switch n := node.(type) {
+		case *fql.VariableDeclarationContext:
+			if id := n.GetId(); id != nil {
+				return id.GetText()
+			}
+
+			return n.SafeReservedWord().GetText()
+		case *fql.FunctionCallExpressionContext,
+			*fql.ExpressionAtomContext,
+			*fql.PredicateContext,
+			*fql.ExpressionContext:
+			if len(n.GetChildren()) != 1 {
+				return ""
+			}
+
+			node = n.GetParent()
+		default:
+			return ""
+		}
+	}
+
+	return ""
+}
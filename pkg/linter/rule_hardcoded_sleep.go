@@ -0,0 +1,66 @@
+package linter
+
+import (
+	"fmt"
+
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+type (
+	// HardcodedSleepRule reports pauses with a hard-coded duration.
+	// Waiting for a particular condition (WAIT_ELEMENT, WAITFOR EVENT, etc.) is more reliable and faster.
+	// Arguments:
+	//   functions - names of functions that pause an execution. Default: ["WAIT"].
+	HardcodedSleepRule struct{}
+
+	hardcodedSleepListener struct {
+		*fql.BaseFqlParserListener
+		file     *File
+		rule     Rule
+		fns      map[string]bool
+		failures []Failure
+	}
+)
+
+func (r *HardcodedSleepRule) Name() string {
+	return "hardcoded-sleep"
+}
+
+func (r *HardcodedSleepRule) Apply(file *File, args Arguments) ([]Failure, error) {
+	fns, err := args.Strings("functions", []string{"WAIT"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	l := &hardcodedSleepListener{
+		BaseFqlParserListener: &fql.BaseFqlParserListener{},
+		file:                  file,
+		rule:                  r,
+		fns:                   toSet(fns),
+	}
+
+	file.Walk(l)
+
+	return l.failures, nil
+}
+
+func (l *hardcodedSleepListener) EnterFunctionCall(ctx *fql.FunctionCallContext) {
+	name := functionName(ctx)
+
+	if !l.fns[name] || ctx.ArgumentList() == nil {
+		return
+	}
+
+	args := ctx.ArgumentList().(*fql.ArgumentListContext).AllExpression()
+
+	if len(args) == 0 || !isConstant(args[0]) {
+		return
+	}
+
+	l.failures = append(l.failures, l.file.Failure(
+		l.rule,
+		ctx,
+		fmt.Sprintf("%s(%s) is a hard-coded sleep, wait for a condition instead", name, args[0].GetText()),
+	))
+}
@@ -0,0 +1,76 @@
+package linter
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+type (
+	// UnboundedWhileRule reports WHILE loops with a constant condition and without a LIMIT clause.
+	UnboundedWhileRule struct{}
+
+	unboundedWhileListener struct {
+		*fql.BaseFqlParserListener
+		file     *File
+		rule     Rule
+		failures []Failure
+	}
+)
+
+func (r *UnboundedWhileRule) Name() string {
+	return "unbounded-while"
+}
+
+func (r *UnboundedWhileRule) Apply(file *File, _ Arguments) ([]Failure, error) {
+	l := &unboundedWhileListener{
+		BaseFqlParserListener: &fql.BaseFqlParserListener{},
+		file:                  file,
+		rule:                  r,
+	}
+
+	file.Walk(l)
+
+	return l.failures, nil
+}
+
+func (l *unboundedWhileListener) EnterForExpression(ctx *fql.ForExpressionContext) {
+	if ctx.While() == nil || !isConstant(ctx.Expression()) {
+		return
+	}
+
+	for _, body := range ctx.AllForExpressionBody() {
+		clause := body.(*fql.ForExpressionBodyContext).ForExpressionClause()
+
+		if clause != nil && clause.(*fql.ForExpressionClauseContext).LimitClause() != nil {
+			return
+		}
+	}
+
+	l.failures = append(l.failures, l.file.Failure(
+		l.rule,
+		ctx,
+		"WHILE loop has a constant condition and no LIMIT clause",
+	))
+}
+
+// isConstant reports whether a given expression consists of literals only.
+func isConstant(node antlr.Tree) bool {
+	switch node.(type) {
+	case *fql.VariableContext,
+		*fql.ParamContext,
+		*fql.FunctionCallContext,
+		*fql.MemberExpressionContext,
+		*fql.ForExpressionContext,
+		*fql.WaitForExpressionContext:
+		return false
+	}
+
+	for _, child := range node.GetChildren() {
+		if !isConstant(child) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,45 @@
+package linter
+
+import (
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+type (
+	// WaitforTimeoutRule reports WAITFOR EVENT expressions without an explicit TIMEOUT clause.
+	WaitforTimeoutRule struct{}
+
+	waitforTimeoutListener struct {
+		*fql.BaseFqlParserListener
+		file     *File
+		rule     Rule
+		failures []Failure
+	}
+)
+
+func (r *WaitforTimeoutRule) Name() string {
+	return "waitfor-timeout"
+}
+
+func (r *WaitforTimeoutRule) Apply(file *File, _ Arguments) ([]Failure, error) {
+	l := &waitforTimeoutListener{
+		BaseFqlParserListener: &fql.BaseFqlParserListener{},
+		file:                  file,
+		rule:                  r,
+	}
+
+	file.Walk(l)
+
+	return l.failures, nil
+}
+
+func (l *waitforTimeoutListener) EnterWaitForExpression(ctx *fql.WaitForExpressionContext) {
+	if ctx.TimeoutClause() != nil {
+		return
+	}
+
+	l.failures = append(l.failures, l.file.Failure(
+		l.rule,
+		ctx,
+		"WAITFOR EVENT without TIMEOUT relies on the default timeout",
+	))
+}
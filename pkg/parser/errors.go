@@ -0,0 +1,17 @@
+package parser
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/pkg/errors"
+)
+
+var ErrUnexpectedToken = errors.New("unexpected token")
+
+// CheckEOF returns an error, if a given token stream has tokens left after a parsed program.
+func CheckEOF(stream antlr.TokenStream) error {
+	if next := stream.LT(1); next != nil && next.GetTokenType() != antlr.TokenEOF {
+		return errors.Wrapf(ErrUnexpectedToken, "'%s' at %d:%d", next.GetText(), next.GetLine(), next.GetColumn())
+	}
+
+	return nil
+}
@@ -1,26 +1,28 @@
-package compiler
+package parser
 
 import (
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/pkg/errors"
 )
 
-type errorListener struct {
+// ErrorListener panics on the first syntax error.
+// Ambiguity reports are ignored.
+type ErrorListener struct {
 	*antlr.DiagnosticErrorListener
 }
 
-func newErrorListener() *errorListener {
-	return &errorListener{
+func NewErrorListener() *ErrorListener {
+	return &ErrorListener{
 		antlr.NewDiagnosticErrorListener(false),
 	}
 }
 
-func (d *errorListener) ReportAttemptingFullContext(_ antlr.Parser, _ *antlr.DFA, _, _ int, _ *antlr.BitSet, _ antlr.ATNConfigSet) {
+func (d *ErrorListener) ReportAttemptingFullContext(_ antlr.Parser, _ *antlr.DFA, _, _ int, _ *antlr.BitSet, _ antlr.ATNConfigSet) {
 }
 
-func (d *errorListener) ReportContextSensitivity(_ antlr.Parser, _ *antlr.DFA, _, _, _ int, _ antlr.ATNConfigSet) {
+func (d *ErrorListener) ReportContextSensitivity(_ antlr.Parser, _ *antlr.DFA, _, _, _ int, _ antlr.ATNConfigSet) {
 }
 
-func (d *errorListener) SyntaxError(_ antlr.Recognizer, _ interface{}, line, column int, msg string, _ antlr.RecognitionException) {
+func (d *ErrorListener) SyntaxError(_ antlr.Recognizer, _ interface{}, line, column int, msg string, _ antlr.RecognitionException) {
 	panic(errors.Errorf("%s at %d:%d", msg, line, column))
 }
@@ -7,7 +7,8 @@ import (
 )
 
 type Parser struct {
-	tree *fql.FqlParser
+	tree   *fql.FqlParser
+	stream *antlr.CommonTokenStream
 }
 
 func New(query string) *Parser {
@@ -22,13 +23,20 @@ func New(query string) *Parser {
 	p := fql.NewFqlParser(stream)
 	p.BuildParseTrees = true
 
-	return &Parser{tree: p}
+	return &Parser{tree: p, stream: stream}
 }
 
 func (p *Parser) GetLiteralNames() []string {
 	return p.tree.GetLiteralNames()[:]
 }
 
+// TokenStream returns the underlying token stream.
+// Tokens from the hidden channel (comments and whitespaces) are available there
+// as well, which makes it possible to reconstruct the original source text.
+func (p *Parser) TokenStream() *antlr.CommonTokenStream {
+	return p.stream
+}
+
 func (p *Parser) AddErrorListener(listener antlr.ErrorListener) {
 	p.tree.AddErrorListener(listener)
 }
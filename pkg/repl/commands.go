@@ -0,0 +1,76 @@
+package repl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type command struct {
+	name        string
+	description string
+	fn          func(r *REPL, out io.Writer) (exit bool, err error)
+}
+
+const commandPrefix = ":"
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{":help", "show available commands", cmdHelp},
+		{":vars", "list declared variables", cmdVars},
+		{":history", "list executed statements", cmdHistory},
+		{":reset", "remove all variables and close opened pages", cmdReset},
+		{":exit", "exit the REPL", cmdExit},
+		{":quit", "exit the REPL", cmdExit},
+	}
+}
+
+func (r *REPL) command(input string, out io.Writer) (bool, error) {
+	name := strings.TrimSpace(input)
+
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd.fn(r, out)
+		}
+	}
+
+	return false, errors.Wrap(ErrUnknownCommand, name)
+}
+
+func cmdHelp(_ *REPL, out io.Writer) (bool, error) {
+	for _, cmd := range commands {
+		fmt.Fprintf(out, "%-10s %s\n", cmd.name, cmd.description)
+	}
+
+	return false, nil
+}
+
+func cmdVars(r *REPL, out io.Writer) (bool, error) {
+	vars := r.Variables()
+
+	for _, name := range r.VariableNames() {
+		fmt.Fprintf(out, "%s: %s\n", name, vars[name].Type())
+	}
+
+	return false, nil
+}
+
+func cmdHistory(r *REPL, out io.Writer) (bool, error) {
+	for i, entry := range r.History() {
+		fmt.Fprintf(out, "%4d  %s\n", i+1, strings.ReplaceAll(entry, "\n", "\n      "))
+	}
+
+	return false, nil
+}
+
+func cmdReset(r *REPL, _ io.Writer) (bool, error) {
+	return false, r.Reset()
+}
+
+func cmdExit(_ *REPL, _ io.Writer) (bool, error) {
+	return true, nil
+}
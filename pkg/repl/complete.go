@@ -0,0 +1,113 @@
+package repl
+
+import (
+	"sort"
+	"strings"
+)
+
+var keywords = []string{
+	"AGGREGATE", "ALL", "AND", "ANY", "ASC", "COLLECT", "COUNT", "CURRENT",
+	"DESC", "DISTINCT", "DO", "EVENT", "FALSE", "FILTER", "FOR", "IN", "INTO",
+	"KEEP", "LET", "LIKE", "LIMIT", "NONE", "NOT", "NULL", "OPTIONS", "OR",
+	"RETURN", "SORT", "TIMEOUT", "TRUE", "USE", "WAITFOR", "WHILE", "WITH",
+}
+
+// Candidates returns names of functions, variables, keywords and commands starting with a given prefix.
+// Functions and keywords are matched case-insensitively.
+func (r *REPL) Candidates(prefix string) []string {
+	out := make([]string, 0, 10)
+
+	if strings.HasPrefix(prefix, commandPrefix) {
+		for _, cmd := range commands {
+			if strings.HasPrefix(cmd.name, prefix) {
+				out = append(out, cmd.name)
+			}
+		}
+
+		return out
+	}
+
+	upper := strings.ToUpper(prefix)
+
+	for _, name := range r.VariableNames() {
+		if strings.HasPrefix(name, prefix) {
+			out = append(out, name)
+		}
+	}
+
+	for _, name := range r.engine.Functions().RegisteredFunctions() {
+		if strings.HasPrefix(name, upper) {
+			out = append(out, name)
+		}
+	}
+
+	for _, kw := range keywords {
+		if strings.HasPrefix(kw, upper) {
+			out = append(out, kw)
+		}
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+// Complete completes a word under the cursor.
+// If there are several candidates, the word is completed up to their common prefix.
+// Positions are indexes of runes, as expected by a terminal.
+func (r *REPL) Complete(line string, pos int) (newLine string, newPos int, ok bool) {
+	runes := []rune(line)
+
+	if pos > len(runes) {
+		return "", 0, false
+	}
+
+	start := pos
+
+	for start > 0 && isWordChar(runes[start-1]) {
+		start--
+	}
+
+	prefix := string(runes[start:pos])
+
+	if prefix == "" {
+		return "", 0, false
+	}
+
+	candidates := r.Candidates(prefix)
+
+	if len(candidates) == 0 {
+		return "", 0, false
+	}
+
+	completion := candidates[0]
+
+	for _, c := range candidates[1:] {
+		completion = commonPrefix(completion, c)
+	}
+
+	if len(completion) < len(prefix) {
+		return "", 0, false
+	}
+
+	newLine = string(runes[:start]) + completion + string(runes[pos:])
+
+	return newLine, start + len([]rune(completion)), true
+}
+
+func isWordChar(c rune) bool {
+	return c == '_' || c == ':' ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9')
+}
+
+func commonPrefix(a, b string) string {
+	i := 0
+
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+
+	return a[:i]
+}
@@ -0,0 +1,184 @@
+package repl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+type lineReader interface {
+	ReadLine() (string, error)
+	SetPrompt(prompt string)
+}
+
+type streamReader struct {
+	scanner *bufio.Scanner
+}
+
+func (s *streamReader) ReadLine() (string, error) {
+	if !s.scanner.Scan() {
+		if err := s.scanner.Err(); err != nil {
+			return "", err
+		}
+
+		return "", io.EOF
+	}
+
+	return s.scanner.Text(), nil
+}
+
+func (s *streamReader) SetPrompt(_ string) {}
+
+// Run starts an interactive session reading input from a given reader until it ends or ":exit" is entered.
+// If the reader is a terminal, the session supports line editing, history navigation and tab completion.
+func (r *REPL) Run(ctx context.Context, in io.Reader, out io.Writer) error {
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		return r.runTerminal(ctx, f, out)
+	}
+
+	reader := &streamReader{bufio.NewScanner(in)}
+
+	return r.loop(ctx, reader, out, func(input string) ([]byte, error) {
+		return r.Exec(ctx, input)
+	})
+}
+
+func (r *REPL) runTerminal(ctx context.Context, in *os.File, out io.Writer) error {
+	fd := int(in.Fd())
+	state, err := term.MakeRaw(fd)
+
+	if err != nil {
+		return err
+	}
+
+	defer term.Restore(fd, state)
+
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{in, out}, r.opts.prompt)
+
+	if width, height, err := term.GetSize(fd); err == nil {
+		_ = t.SetSize(width, height)
+	}
+
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+
+		return r.Complete(line, pos)
+	}
+
+	return r.loop(ctx, t, t, func(input string) ([]byte, error) {
+		// the terminal is switched back to the normal mode during an execution,
+		// so that Ctrl+C interrupts the statement instead of the whole session
+		if err := term.Restore(fd, state); err != nil {
+			return nil, err
+		}
+
+		defer term.MakeRaw(fd)
+
+		execCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt)
+		defer signal.Stop(signals)
+
+		go func() {
+			select {
+			case <-signals:
+				cancel()
+			case <-execCtx.Done():
+			}
+		}()
+
+		return r.Exec(execCtx, input)
+	})
+}
+
+func (r *REPL) loop(ctx context.Context, reader lineReader, out io.Writer, exec func(input string) ([]byte, error)) error {
+	continuation := "... "
+
+	if len(r.opts.prompt) > len(continuation) {
+		continuation = strings.Repeat(" ", len(r.opts.prompt)-len(continuation)) + continuation
+	}
+
+	buf := &strings.Builder{}
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if buf.Len() == 0 {
+			reader.SetPrompt(r.opts.prompt)
+		} else {
+			reader.SetPrompt(continuation)
+		}
+
+		line, err := reader.ReadLine()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if buf.Len() == 0 {
+			trimmed := strings.TrimSpace(line)
+
+			if trimmed == "" {
+				continue
+			}
+
+			if strings.HasPrefix(trimmed, commandPrefix) {
+				exit, err := r.command(trimmed, out)
+
+				if err != nil {
+					fmt.Fprintf(out, "Error: %s\n", err)
+				}
+
+				if exit {
+					return nil
+				}
+
+				continue
+			}
+		} else {
+			buf.WriteString("\n")
+		}
+
+		buf.WriteString(line)
+		input := buf.String()
+
+		// an empty line forces an execution of incomplete input
+		if strings.TrimSpace(line) != "" && !isComplete(input) {
+			continue
+		}
+
+		buf.Reset()
+		r.addHistory(input)
+
+		res, err := exec(input)
+
+		if err != nil {
+			fmt.Fprintf(out, "Error: %s\n", err)
+
+			continue
+		}
+
+		if res != nil {
+			fmt.Fprintln(out, string(res))
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package repl
+
+import "github.com/pkg/errors"
+
+var (
+	ErrClosed         = errors.New("repl is closed")
+	ErrUnknownCommand = errors.New("unknown command")
+)
@@ -0,0 +1,50 @@
+package repl
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+)
+
+// loadHistory reads entries from a history file.
+// Each entry is stored as a JSON string on its own line, since entries might be multi-line.
+func loadHistory(path string) []string {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil
+	}
+
+	defer file.Close()
+
+	out := make([]string, 0, 10)
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var entry string
+
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil {
+			out = append(out, entry)
+		}
+	}
+
+	return out
+}
+
+func appendHistory(path, entry string) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return
+	}
+
+	defer file.Close()
+
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return
+	}
+
+	_, _ = file.Write(append(b, '\n'))
+}
@@ -0,0 +1,154 @@
+package repl
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	"github.com/MontFerret/ferret/pkg/parser"
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+)
+
+type inputKind int
+
+const (
+	// a query with a body expression (RETURN or FOR)
+	inputQuery inputKind = iota
+	// one or more statements without a body expression (LET, WAITFOR)
+	inputStatement
+	// a single expression whose value is printed
+	inputExpression
+)
+
+// tokens that can not end a complete statement
+var continuationTokens = map[int]bool{
+	fql.FqlLexerColon:         true,
+	fql.FqlLexerDot:           true,
+	fql.FqlLexerComma:         true,
+	fql.FqlLexerGt:            true,
+	fql.FqlLexerLt:            true,
+	fql.FqlLexerEq:            true,
+	fql.FqlLexerGte:           true,
+	fql.FqlLexerLte:           true,
+	fql.FqlLexerNeq:           true,
+	fql.FqlLexerMulti:         true,
+	fql.FqlLexerDiv:           true,
+	fql.FqlLexerMod:           true,
+	fql.FqlLexerPlus:          true,
+	fql.FqlLexerMinus:         true,
+	fql.FqlLexerAnd:           true,
+	fql.FqlLexerOr:            true,
+	fql.FqlLexerRange:         true,
+	fql.FqlLexerAssign:        true,
+	fql.FqlLexerRegexNotMatch: true,
+	fql.FqlLexerRegexMatch:    true,
+	fql.FqlLexerFor:           true,
+	fql.FqlLexerReturn:        true,
+	fql.FqlLexerWaitfor:       true,
+	fql.FqlLexerOptions:       true,
+	fql.FqlLexerTimeout:       true,
+	fql.FqlLexerDistinct:      true,
+	fql.FqlLexerFilter:        true,
+	fql.FqlLexerSort:          true,
+	fql.FqlLexerLimit:         true,
+	fql.FqlLexerLet:           true,
+	fql.FqlLexerCollect:       true,
+	fql.FqlLexerInto:          true,
+	fql.FqlLexerKeep:          true,
+	fql.FqlLexerWith:          true,
+	fql.FqlLexerAggregate:     true,
+	fql.FqlLexerEvent:         true,
+	fql.FqlLexerLike:          true,
+	fql.FqlLexerNot:           true,
+	fql.FqlLexerIn:            true,
+	fql.FqlLexerDo:            true,
+	fql.FqlLexerWhile:         true,
+	fql.FqlLexerParam:         true,
+}
+
+func tokenize(input string) []antlr.Token {
+	stream := parser.New(input).TokenStream()
+	stream.Fill()
+
+	all := stream.GetAllTokens()
+	out := make([]antlr.Token, 0, len(all))
+
+	for _, t := range all {
+		if t.GetChannel() == antlr.TokenDefaultChannel && t.GetTokenType() != antlr.TokenEOF {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
+
+// isComplete reports whether a given input looks like a complete statement.
+// Incomplete input is continued on the next line.
+func isComplete(input string) bool {
+	tokens := tokenize(input)
+
+	if len(tokens) == 0 {
+		return true
+	}
+
+	depth := 0
+	loops := 0
+
+	for _, t := range tokens {
+		switch t.GetTokenType() {
+		case fql.FqlLexerOpenParen, fql.FqlLexerOpenBracket, fql.FqlLexerOpenBrace:
+			depth++
+		case fql.FqlLexerCloseParen, fql.FqlLexerCloseBracket, fql.FqlLexerCloseBrace:
+			depth--
+		case fql.FqlLexerFor:
+			if depth == 0 {
+				loops++
+			}
+		case fql.FqlLexerReturn:
+			if depth == 0 {
+				loops = 0
+			}
+		case fql.FqlLexerUnknownIdentifier:
+			switch t.GetText() {
+			case `"`, "'", "`", "´":
+				// unterminated string literal
+				return false
+			}
+		}
+	}
+
+	if depth > 0 || loops > 0 {
+		return false
+	}
+
+	return !continuationTokens[tokens[len(tokens)-1].GetTokenType()]
+}
+
+// classify detects what kind of input is given.
+func classify(input string) inputKind {
+	tokens := tokenize(input)
+
+	if len(tokens) == 0 {
+		return inputQuery
+	}
+
+	depth := 0
+
+	for _, t := range tokens {
+		switch t.GetTokenType() {
+		case fql.FqlLexerOpenParen, fql.FqlLexerOpenBracket, fql.FqlLexerOpenBrace:
+			depth++
+		case fql.FqlLexerCloseParen, fql.FqlLexerCloseBracket, fql.FqlLexerCloseBrace:
+			depth--
+		case fql.FqlLexerReturn, fql.FqlLexerFor:
+			if depth == 0 {
+				return inputQuery
+			}
+		}
+	}
+
+	switch tokens[0].GetTokenType() {
+	case fql.FqlLexerLet, fql.FqlLexerWaitfor, fql.FqlLexerUse:
+		return inputStatement
+	default:
+		return inputExpression
+	}
+}
@@ -0,0 +1,50 @@
+package repl
+
+import (
+	"github.com/MontFerret/ferret/pkg/runtime"
+)
+
+type (
+	Option  func(opts *Options)
+	Options struct {
+		prompt      string
+		history     string
+		runtimeOpts []runtime.Option
+	}
+)
+
+const DefaultPrompt = "ferret> "
+
+func newOptions(setters []Option) *Options {
+	opts := &Options{
+		prompt: DefaultPrompt,
+	}
+
+	for _, setter := range setters {
+		setter(opts)
+	}
+
+	return opts
+}
+
+// WithPrompt sets a prompt displayed before each statement.
+func WithPrompt(prompt string) Option {
+	return func(opts *Options) {
+		opts.prompt = prompt
+	}
+}
+
+// WithHistoryFile sets a path to a file executed statements are appended to.
+// Statements from the file are available via the ":history" command in subsequent sessions.
+func WithHistoryFile(path string) Option {
+	return func(opts *Options) {
+		opts.history = path
+	}
+}
+
+// WithRuntimeOptions sets options used for executing each statement, e.g. params and logging.
+func WithRuntimeOptions(setters ...runtime.Option) Option {
+	return func(opts *Options) {
+		opts.runtimeOpts = append(opts.runtimeOpts, setters...)
+	}
+}
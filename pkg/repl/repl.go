@@ -0,0 +1,136 @@
+package repl
+
+import (
+	"context"
+	"sort"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// REPL executes FQL statements one by one against a persistent scope.
+// Variables declared by a statement, including opened pages, stay alive for subsequent statements
+// until the REPL is reset or closed.
+type REPL struct {
+	engine  *ferret.Instance
+	opts    *Options
+	scope   *core.Scope
+	closeFn core.CloseFunc
+	history []string
+}
+
+func New(engine *ferret.Instance, setters ...Option) *REPL {
+	r := &REPL{
+		engine:  engine,
+		opts:    newOptions(setters),
+		history: make([]string, 0, 10),
+	}
+
+	r.scope, r.closeFn = core.NewRootScope()
+
+	if r.opts.history != "" {
+		r.history = append(r.history, loadHistory(r.opts.history)...)
+	}
+
+	return r
+}
+
+// Exec executes a given input.
+// The input is either a complete query, one or more statements (LET, WAITFOR)
+// or a single expression, e.g. "doc.url".
+// Statements produce no output.
+func (r *REPL) Exec(ctx context.Context, input string) ([]byte, error) {
+	if r.scope == nil {
+		return nil, ErrClosed
+	}
+
+	kind := classify(input)
+	query := input
+
+	switch kind {
+	case inputStatement:
+		query = input + "\nRETURN NONE"
+	case inputExpression:
+		query = "RETURN " + input
+	}
+
+	program, err := r.engine.CompileWithVariables(query, r.VariableNames())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// each statement is executed in its own scope,
+	// which allows to redeclare variables
+	scope := r.scope.Fork()
+
+	out, err := r.engine.RunInScope(ctx, program, scope, r.opts.runtimeOpts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.scope = scope
+
+	if kind == inputStatement {
+		return nil, nil
+	}
+
+	return out, nil
+}
+
+// Variables returns all declared variables.
+func (r *REPL) Variables() map[string]core.Value {
+	if r.scope == nil {
+		return map[string]core.Value{}
+	}
+
+	return r.scope.Variables()
+}
+
+// VariableNames returns sorted names of all declared variables.
+func (r *REPL) VariableNames() []string {
+	vars := r.Variables()
+	names := make([]string, 0, len(vars))
+
+	for name := range vars {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// History returns previously executed inputs.
+func (r *REPL) History() []string {
+	return r.history[:]
+}
+
+// Reset removes all declared variables and closes opened pages.
+func (r *REPL) Reset() error {
+	err := r.Close()
+
+	r.scope, r.closeFn = core.NewRootScope()
+
+	return err
+}
+
+// Close closes all values created by executed statements.
+func (r *REPL) Close() error {
+	if r.scope == nil {
+		return ErrClosed
+	}
+
+	r.scope = nil
+
+	return r.closeFn()
+}
+
+func (r *REPL) addHistory(input string) {
+	r.history = append(r.history, input)
+
+	if r.opts.history != "" {
+		appendHistory(r.opts.history, input)
+	}
+}
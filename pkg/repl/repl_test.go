@@ -0,0 +1,151 @@
+package repl_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/repl"
+	"github.com/MontFerret/ferret/pkg/runtime"
+)
+
+func TestREPL(t *testing.T) {
+	Convey(".Exec", t, func() {
+		Convey("Should keep variables between statements", func() {
+			r := repl.New(ferret.New())
+			defer r.Close()
+
+			out, err := r.Exec(context.Background(), `LET foo = "bar"`)
+
+			So(err, ShouldBeNil)
+			So(out, ShouldBeNil)
+
+			out, err = r.Exec(context.Background(), `LET baz = foo + "!"`)
+
+			So(err, ShouldBeNil)
+
+			out, err = r.Exec(context.Background(), `baz`)
+
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `"bar!"`)
+			So(r.VariableNames(), ShouldResemble, []string{"baz", "foo"})
+		})
+
+		Convey("Should allow to redeclare variables", func() {
+			r := repl.New(ferret.New())
+			defer r.Close()
+
+			_, err := r.Exec(context.Background(), `LET foo = 1`)
+			So(err, ShouldBeNil)
+
+			_, err = r.Exec(context.Background(), `LET foo = foo + 1`)
+			So(err, ShouldBeNil)
+
+			out, err := r.Exec(context.Background(), `RETURN foo`)
+
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `2`)
+		})
+
+		Convey("Should execute complete queries", func() {
+			r := repl.New(ferret.New(), repl.WithRuntimeOptions(runtime.WithParam("items", []int{1, 2})))
+			defer r.Close()
+
+			out, err := r.Exec(context.Background(), `FOR i IN @items RETURN i * 2`)
+
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `[2,4]`)
+		})
+
+		Convey("Should not keep variables of failed statements", func() {
+			r := repl.New(ferret.New())
+			defer r.Close()
+
+			_, err := r.Exec(context.Background(), `LET foo = 1 LET bar = FAIL()`)
+
+			So(err, ShouldNotBeNil)
+			So(r.VariableNames(), ShouldBeEmpty)
+		})
+
+		Convey("Should remove variables on reset", func() {
+			r := repl.New(ferret.New())
+			defer r.Close()
+
+			_, err := r.Exec(context.Background(), `LET foo = 1`)
+			So(err, ShouldBeNil)
+
+			So(r.Reset(), ShouldBeNil)
+			So(r.VariableNames(), ShouldBeEmpty)
+		})
+	})
+
+	Convey(".Complete", t, func() {
+		r := repl.New(ferret.New())
+		defer r.Close()
+
+		_, err := r.Exec(context.Background(), `LET page_url = "https://www.google.com"`)
+		So(err, ShouldBeNil)
+
+		line, pos, ok := r.Complete("LET doc = docum", 15)
+
+		So(ok, ShouldBeTrue)
+		So(line, ShouldEqual, "LET doc = DOCUMENT")
+		So(pos, ShouldEqual, 18)
+
+		line, pos, ok = r.Complete("RETURN page_", 12)
+
+		So(ok, ShouldBeTrue)
+		So(line, ShouldEqual, "RETURN page_url")
+		So(pos, ShouldEqual, 15)
+
+		line, _, ok = r.Complete("RETURN INNER_", 13)
+
+		So(ok, ShouldBeTrue)
+		So(line, ShouldEqual, "RETURN INNER_")
+		So(r.Candidates("INNER_TEXT"), ShouldResemble, []string{"INNER_TEXT", "INNER_TEXT_ALL", "INNER_TEXT_SET"})
+
+		line, _, ok = r.Complete(":va", 3)
+
+		So(ok, ShouldBeTrue)
+		So(line, ShouldEqual, ":vars")
+
+		line, pos, ok = r.Complete(`LET s = "ö" + docum`, 19)
+
+		So(ok, ShouldBeTrue)
+		So(line, ShouldEqual, `LET s = "ö" + DOCUMENT`)
+		So(pos, ShouldEqual, 22)
+	})
+
+	Convey(".Run", t, func() {
+		history := filepath.Join(t.TempDir(), "history")
+		r := repl.New(ferret.New(), repl.WithHistoryFile(history))
+		defer r.Close()
+
+		in := strings.NewReader(strings.Join([]string{
+			`LET items = [`,
+			`  1, 2, 3`,
+			`]`,
+			`FOR i IN items`,
+			`  FILTER i > 1`,
+			`  RETURN i`,
+			`:vars`,
+			`:foo`,
+			`:exit`,
+			`RETURN 1`,
+		}, "\n"))
+		out := &bytes.Buffer{}
+
+		So(r.Run(context.Background(), in, out), ShouldBeNil)
+		So(out.String(), ShouldEqual, "[2,3]\nitems: array\nError: :foo: unknown command\n")
+
+		So(repl.New(ferret.New(), repl.WithHistoryFile(history)).History(), ShouldResemble, []string{
+			"LET items = [\n  1, 2, 3\n]",
+			"FOR i IN items\n  FILTER i > 1\n  RETURN i",
+		})
+	})
+}
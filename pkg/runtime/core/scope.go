@@ -152,3 +152,21 @@ func (s *Scope) Fork() *Scope {
 
 	return child
 }
+
+// Variables returns all variables visible from the scope.
+// Variables declared in the scope shadow variables with the same names declared in its parents.
+func (s *Scope) Variables() map[string]Value {
+	var out map[string]Value
+
+	if s.parent != nil {
+		out = s.parent.Variables()
+	} else {
+		out = make(map[string]Value, len(s.vars))
+	}
+
+	for name, val := range s.vars {
+		out[name] = val
+	}
+
+	return out
+}
@@ -150,6 +150,30 @@ func TestScope(t *testing.T) {
 			})
 		})
 	})
+
+	Convey(".Variables", t, func() {
+		Convey("Should return variables of the scope and its parents", func() {
+			rs, cf := core.NewRootScope()
+			So(cf, ShouldNotBeNil)
+
+			err := rs.SetVariable("foo", values.NewString("bar"))
+			So(err, ShouldBeNil)
+
+			err = rs.SetVariable("faz", values.NewString("qaz"))
+			So(err, ShouldBeNil)
+
+			cs := rs.Fork()
+			err = cs.SetVariable("foo", values.NewString("baz"))
+			So(err, ShouldBeNil)
+
+			vars := cs.Variables()
+
+			So(vars, ShouldHaveLength, 2)
+			So(vars["foo"], ShouldEqual, "baz")
+			So(vars["faz"], ShouldEqual, "qaz")
+			So(rs.Variables()["foo"], ShouldEqual, "bar")
+		})
+	})
 }
 
 func BenchmarkScope(b *testing.B) {
@@ -31,21 +31,70 @@ func (b *BodyExpression) Add(exp core.Expression) error {
 }
 
 func (b *BodyExpression) Exec(ctx context.Context, scope *core.Scope) (core.Value, error) {
+	if err := b.execStatements(ctx, scope); err != nil {
+		return values.None, err
+	}
+
+	if b.expression != nil {
+		return b.expression.Exec(ctx, scope)
+	}
+
+	return values.None, nil
+}
+
+// Stream executes the body and passes elements of its result to a given function one by one.
+// Elements of a FOR expression are passed as soon as they are produced,
+// a result that is not an array is passed as a single element.
+func (b *BodyExpression) Stream(ctx context.Context, scope *core.Scope, emit func(value core.Value) error) error {
+	if err := b.execStatements(ctx, scope); err != nil {
+		return err
+	}
+
+	if exp, ok := b.expression.(*ForExpression); ok {
+		return exp.Stream(ctx, scope, emit)
+	}
+
+	out := core.Value(values.None)
+
+	if b.expression != nil {
+		val, err := b.expression.Exec(ctx, scope)
+
+		if err != nil {
+			return err
+		}
+
+		out = val
+	}
+
+	arr, ok := out.(*values.Array)
+
+	if !ok {
+		return emit(out)
+	}
+
+	var err error
+
+	arr.ForEach(func(value core.Value, _ int) bool {
+		err = emit(value)
+
+		return err == nil
+	})
+
+	return err
+}
+
+func (b *BodyExpression) execStatements(ctx context.Context, scope *core.Scope) error {
 	select {
 	case <-ctx.Done():
-		return values.None, core.ErrTerminated
+		return core.ErrTerminated
 	default:
 	}
 
 	for _, exp := range b.statements {
 		if _, err := exp.Exec(ctx, scope); err != nil {
-			return values.None, err
+			return err
 		}
 	}
 
-	if b.expression != nil {
-		return b.expression.Exec(ctx, scope)
-	}
-
-	return values.None, nil
+	return nil
 }
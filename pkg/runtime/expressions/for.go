@@ -112,21 +112,39 @@ func (e *ForExpression) AddStatement(stmt core.Expression) error {
 }
 
 func (e *ForExpression) Exec(ctx context.Context, scope *core.Scope) (core.Value, error) {
+	res := e.newResult()
+
+	if err := e.iterate(ctx, scope, res); err != nil {
+		return values.None, err
+	}
+
+	return res.ToArray(), nil
+}
+
+// Stream executes the expression and passes elements of its result to a given function
+// as soon as they are produced, instead of collecting them into an array.
+func (e *ForExpression) Stream(ctx context.Context, scope *core.Scope, emit func(value core.Value) error) error {
+	return e.iterate(ctx, scope, e.newResult().Emit(emit))
+}
+
+func (e *ForExpression) newResult() *ForResult {
+	return NewForResult(10).
+		Distinct(e.distinct).
+		Spread(e.spread).
+		PassThrough(e.passThrough)
+}
+
+func (e *ForExpression) iterate(ctx context.Context, scope *core.Scope, res *ForResult) error {
 	select {
 	case <-ctx.Done():
-		return values.None, core.ErrTerminated
+		return core.ErrTerminated
 	default:
 		iterator, err := e.dataSource.Iterate(ctx, scope)
 
 		if err != nil {
-			return values.None, err
+			return err
 		}
 
-		res := NewForResult(10).
-			Distinct(e.distinct).
-			Spread(e.spread).
-			PassThrough(e.passThrough)
-
 		for {
 			nextScope, err := iterator.Next(ctx, scope)
 
@@ -135,18 +153,22 @@ func (e *ForExpression) Exec(ctx context.Context, scope *core.Scope) (core.Value
 					break
 				}
 
-				return values.None, core.SourceError(e.src, err)
+				return core.SourceError(e.src, err)
 			}
 
 			out, err := e.predicate.Exec(ctx, nextScope)
 
 			if err != nil {
-				return values.None, err
+				return err
 			}
 
 			res.Push(out)
+
+			if err := res.Err(); err != nil {
+				return err
+			}
 		}
 
-		return res.ToArray(), nil
+		return nil
 	}
 }
@@ -11,6 +11,8 @@ type ForResult struct {
 	distinct    bool
 	spread      bool
 	passThrough bool
+	emit        func(value core.Value) error
+	err         error
 }
 
 func NewForResult(capacity int) *ForResult {
@@ -44,6 +46,14 @@ func (f *ForResult) PassThrough(passThrough bool) *ForResult {
 	return f
 }
 
+// Emit makes the result pass its items to a given function instead of collecting them.
+// Once the function fails, the rest of the items are dropped and the error is returned by Err.
+func (f *ForResult) Emit(emit func(value core.Value) error) *ForResult {
+	f.emit = emit
+
+	return f
+}
+
 func (f *ForResult) Push(value core.Value) {
 	if f.passThrough {
 		return
@@ -63,7 +73,7 @@ func (f *ForResult) Push(value core.Value) {
 	}
 
 	if !f.spread {
-		f.itemList.Push(value)
+		f.add(value)
 
 		return
 	}
@@ -71,7 +81,7 @@ func (f *ForResult) Push(value core.Value) {
 	elements, ok := value.(*values.Array)
 
 	if !ok {
-		f.itemList.Push(value)
+		f.add(value)
 
 		return
 	}
@@ -86,3 +96,19 @@ func (f *ForResult) Push(value core.Value) {
 func (f *ForResult) ToArray() *values.Array {
 	return f.itemList
 }
+
+func (f *ForResult) Err() error {
+	return f.err
+}
+
+func (f *ForResult) add(value core.Value) {
+	if f.emit == nil {
+		f.itemList.Push(value)
+
+		return
+	}
+
+	if f.err == nil {
+		f.err = f.emit(value)
+	}
+}
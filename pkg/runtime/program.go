@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/logging"
@@ -45,12 +46,26 @@ func (p *Program) Params() []string {
 }
 
 func (p *Program) Run(ctx context.Context, setters ...Option) (result []byte, err error) {
-	opts := NewOptions(setters)
+	scope, closeFn := core.NewRootScope()
 
-	err = p.validateParams(opts)
+	defer closeRootScope(ctx, setters, closeFn)
 
-	if err != nil {
-		return nil, err
+	return p.RunInScope(ctx, scope, setters...)
+}
+
+// Stream executes the program and passes elements of its result to a given function one by one, encoded as JSON.
+// Elements returned by a top-level FOR expression are passed as soon as they are produced,
+// a result that is not an array is passed as a single element.
+// Execution stops once the function returns an error.
+func (p *Program) Stream(ctx context.Context, emit func(element []byte) error, setters ...Option) (err error) {
+	scope, closeFn := core.NewRootScope()
+
+	defer closeRootScope(ctx, setters, closeFn)
+
+	opts := NewOptions(setters)
+
+	if err := p.validateParams(opts); err != nil {
+		return err
 	}
 
 	ctx = opts.WithContext(ctx)
@@ -58,33 +73,59 @@ func (p *Program) Run(ctx context.Context, setters ...Option) (result []byte, er
 
 	defer func() {
 		if r := recover(); r != nil {
-			switch x := r.(type) {
-			case string:
-				err = errors.New(x)
-			case error:
-				err = errors.WithStack(err)
-			default:
-				err = errors.New("unknown panic")
-			}
+			err = recoverPanic(logger, r)
+		}
+	}()
 
-			logger.Error().
-				Timestamp().
-				Err(err).
-				Str("stack", fmt.Sprintf("%+v", err)).
-				Msg("panic")
+	emitJSON := func(value core.Value) error {
+		out, err := value.MarshalJSON()
 
-			result = nil
+		if err != nil {
+			return err
 		}
-	}()
 
-	scope, closeFn := core.NewRootScope()
+		return emit(out)
+	}
+
+	if body, ok := p.body.(interface {
+		Stream(ctx context.Context, scope *core.Scope, emit func(value core.Value) error) error
+	}); ok {
+		return body.Stream(ctx, scope, emitJSON)
+	}
+
+	out, err := p.body.Exec(ctx, scope)
+
+	if err != nil {
+		return err
+	}
+
+	return emitJSON(out)
+}
+
+// RunInScope executes the program using a given scope.
+// Unlike Run, it does not close the scope afterwards,
+// thus values created by the program, like opened pages, stay alive
+// and can be used by other programs executed in the same scope.
+func (p *Program) RunInScope(ctx context.Context, scope *core.Scope, setters ...Option) (result []byte, err error) {
+	if scope == nil {
+		return nil, core.Error(core.ErrMissedArgument, "scope")
+	}
+
+	opts := NewOptions(setters)
+
+	err = p.validateParams(opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = opts.WithContext(ctx)
+	logger := logging.FromContext(ctx)
 
 	defer func() {
-		if err := closeFn(); err != nil {
-			logger.Error().
-				Timestamp().
-				Err(err).
-				Msg("closing root scope")
+		if r := recover(); r != nil {
+			err = recoverPanic(logger, r)
+			result = nil
 		}
 	}()
 
@@ -136,3 +177,35 @@ func (p *Program) validateParams(opts *Options) error {
 
 	return nil
 }
+
+func closeRootScope(ctx context.Context, setters []Option, closeFn core.CloseFunc) {
+	if err := closeFn(); err != nil {
+		logger := logging.FromContext(NewOptions(setters).WithContext(ctx))
+
+		logger.Error().
+			Timestamp().
+			Err(err).
+			Msg("closing root scope")
+	}
+}
+
+func recoverPanic(logger zerolog.Logger, r interface{}) error {
+	var err error
+
+	switch x := r.(type) {
+	case string:
+		err = errors.New(x)
+	case error:
+		err = errors.WithStack(x)
+	default:
+		err = errors.New("unknown panic")
+	}
+
+	logger.Error().
+		Timestamp().
+		Err(err).
+		Str("stack", fmt.Sprintf("%+v", err)).
+		Msg("panic")
+
+	return err
+}
@@ -39,4 +39,83 @@ func TestProgram(t *testing.T) {
 
 		So(err, ShouldEqual, core.ErrTerminated)
 	})
+
+	Convey("Should run in a given scope without closing it", t, func() {
+		c := compiler.New()
+		scope, closeFn := core.NewRootScope()
+
+		p := c.MustCompile(`LET foo = "bar" RETURN foo`)
+
+		_, err := p.RunInScope(context.Background(), scope)
+
+		So(err, ShouldBeNil)
+		So(scope.HasVariable("foo"), ShouldBeTrue)
+
+		p, err = c.CompileWithVariables(`LET baz = foo + "!" RETURN baz`, []string{"foo"})
+
+		So(err, ShouldBeNil)
+
+		out, err := p.RunInScope(context.Background(), scope.Fork())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `"bar!"`)
+		So(closeFn(), ShouldBeNil)
+	})
+
+	Convey("Should stream elements as soon as they are produced", t, func() {
+		c := compiler.New()
+		produced := 0
+		c.RegisterFunction("produce", func(ctx context.Context, args ...core.Value) (core.Value, error) {
+			produced++
+
+			return args[0], nil
+		})
+
+		p := c.MustCompile(`FOR i IN 1..3 RETURN PRODUCE({ i })`)
+
+		out := make([]string, 0, 3)
+
+		err := p.Stream(context.Background(), func(element []byte) error {
+			So(produced, ShouldEqual, len(out)+1)
+
+			out = append(out, string(element))
+
+			return nil
+		})
+
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, []string{`{"i":1}`, `{"i":2}`, `{"i":3}`})
+	})
+
+	Convey("Should stop streaming when emit fails", t, func() {
+		c := compiler.New()
+		p := c.MustCompile(`FOR i IN 1..3 RETURN i`)
+
+		count := 0
+
+		err := p.Stream(context.Background(), func(element []byte) error {
+			count++
+
+			return core.ErrTerminated
+		})
+
+		So(err, ShouldEqual, core.ErrTerminated)
+		So(count, ShouldEqual, 1)
+	})
+
+	Convey("Should stream a non-array result as a single element", t, func() {
+		c := compiler.New()
+		p := c.MustCompile(`RETURN "foo"`)
+
+		out := make([]string, 0, 1)
+
+		err := p.Stream(context.Background(), func(element []byte) error {
+			out = append(out, string(element))
+
+			return nil
+		})
+
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, []string{`"foo"`})
+	})
 }
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/MontFerret/ferret/pkg/runtime"
+)
+
+type (
+	cacheEntry struct {
+		key     string
+		program *runtime.Program
+	}
+
+	// programCache is an LRU cache of compiled programs keyed by a hash of their source.
+	programCache struct {
+		mu      sync.Mutex
+		size    int
+		items   map[string]*list.Element
+		entries *list.List
+	}
+)
+
+func newProgramCache(size int) *programCache {
+	return &programCache{
+		size:    size,
+		items:   make(map[string]*list.Element, size),
+		entries: list.New(),
+	}
+}
+
+func hashQuery(query string) string {
+	sum := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *programCache) Get(key string) (*runtime.Program, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, found := c.items[key]
+
+	if !found {
+		return nil, false
+	}
+
+	c.entries.MoveToFront(el)
+
+	return el.Value.(*cacheEntry).program, true
+}
+
+func (c *programCache) Set(key string, program *runtime.Program) {
+	if c.size == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, found := c.items[key]; found {
+		el.Value.(*cacheEntry).program = program
+		c.entries.MoveToFront(el)
+
+		return
+	}
+
+	c.items[key] = c.entries.PushFront(&cacheEntry{key, program})
+
+	if c.entries.Len() > c.size {
+		oldest := c.entries.Back()
+		c.entries.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *programCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.entries.Len()
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+)
+
+func TestProgramCache(t *testing.T) {
+	Convey("Should evict least recently used programs", t, func() {
+		c := compiler.New()
+		cache := newProgramCache(2)
+
+		for _, query := range []string{"RETURN 1", "RETURN 2", "RETURN 3"} {
+			if query == "RETURN 3" {
+				// touch the first one, so the second one gets evicted
+				_, found := cache.Get(hashQuery("RETURN 1"))
+				So(found, ShouldBeTrue)
+			}
+
+			cache.Set(hashQuery(query), c.MustCompile(query))
+		}
+
+		So(cache.Len(), ShouldEqual, 2)
+
+		_, found := cache.Get(hashQuery("RETURN 2"))
+		So(found, ShouldBeFalse)
+
+		p, found := cache.Get(hashQuery("RETURN 1"))
+		So(found, ShouldBeTrue)
+		So(p.Source(), ShouldEqual, "RETURN 1")
+	})
+
+	Convey("Should not keep anything when disabled", t, func() {
+		cache := newProgramCache(0)
+		cache.Set(hashQuery("RETURN 1"), compiler.New().MustCompile("RETURN 1"))
+
+		So(cache.Len(), ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,10 @@
+package server
+
+import "github.com/pkg/errors"
+
+var (
+	ErrClosed       = errors.New("server is closed")
+	ErrMissedQuery  = errors.New("missed query")
+	ErrInvalidInput = errors.New("invalid request")
+	ErrTooLarge     = errors.New("request body is too large")
+)
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/runtime"
+)
+
+type (
+	Option  func(opts *Options)
+	Options struct {
+		poolSize    int
+		cacheSize   int
+		timeout     time.Duration
+		maxTimeout  time.Duration
+		maxBodySize int64
+		runtimeOpts []runtime.Option
+	}
+)
+
+const (
+	DefaultPoolSize    = 10
+	DefaultCacheSize   = 100
+	DefaultMaxBodySize = 1 << 20
+)
+
+func newOptions(setters []Option) *Options {
+	opts := &Options{
+		poolSize:    DefaultPoolSize,
+		cacheSize:   DefaultCacheSize,
+		maxBodySize: DefaultMaxBodySize,
+	}
+
+	for _, setter := range setters {
+		setter(opts)
+	}
+
+	return opts
+}
+
+// WithPoolSize sets a maximum number of instances, and therefore queries, running at the same time.
+func WithPoolSize(size int) Option {
+	return func(opts *Options) {
+		if size > 0 {
+			opts.poolSize = size
+		}
+	}
+}
+
+// WithCacheSize sets a maximum number of compiled programs kept in memory.
+// Zero disables caching.
+func WithCacheSize(size int) Option {
+	return func(opts *Options) {
+		if size >= 0 {
+			opts.cacheSize = size
+		}
+	}
+}
+
+// WithTimeout sets an execution timeout used when a request does not specify one.
+func WithTimeout(timeout time.Duration) Option {
+	return func(opts *Options) {
+		opts.timeout = timeout
+	}
+}
+
+// WithMaxTimeout sets an upper limit of execution timeouts requested by clients.
+func WithMaxTimeout(timeout time.Duration) Option {
+	return func(opts *Options) {
+		opts.maxTimeout = timeout
+	}
+}
+
+// WithMaxBodySize sets a maximum size of a request body in bytes.
+func WithMaxBodySize(size int64) Option {
+	return func(opts *Options) {
+		if size > 0 {
+			opts.maxBodySize = size
+		}
+	}
+}
+
+// WithRuntimeOptions sets options used for executing each query, e.g. default params and logging.
+// Params passed with a request take precedence over default ones.
+func WithRuntimeOptions(setters ...runtime.Option) Option {
+	return func(opts *Options) {
+		opts.runtimeOpts = append(opts.runtimeOpts, setters...)
+	}
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+type (
+	// Factory creates an instance with its own drivers container.
+	// Instances are pooled, so a driver is never used by more than one query at a time.
+	Factory func() (*ferret.Instance, error)
+
+	pool struct {
+		mu        sync.Mutex
+		factory   Factory
+		idle      chan *ferret.Instance
+		slots     chan struct{}
+		instances []*ferret.Instance
+		closed    bool
+	}
+)
+
+func newPool(factory Factory, size int) *pool {
+	return &pool{
+		factory:   factory,
+		idle:      make(chan *ferret.Instance, size),
+		slots:     make(chan struct{}, size),
+		instances: make([]*ferret.Instance, 0, size),
+	}
+}
+
+// Acquire returns an idle instance or creates a new one if the pool is not full yet.
+// It blocks until an instance is available or a given context is done.
+func (p *pool) Acquire(ctx context.Context) (*ferret.Instance, error) {
+	select {
+	case inst := <-p.idle:
+		return inst, nil
+	default:
+	}
+
+	select {
+	case inst := <-p.idle:
+		return inst, nil
+	case p.slots <- struct{}{}:
+		inst, err := p.create()
+
+		if err != nil {
+			<-p.slots
+
+			return nil, err
+		}
+
+		return inst, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Release returns a given instance back to the pool.
+func (p *pool) Release(inst *ferret.Instance) {
+	p.idle <- inst
+}
+
+func (p *pool) create() (*ferret.Instance, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, ErrClosed
+	}
+
+	inst, err := p.factory()
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.instances = append(p.instances, inst)
+
+	return inst, nil
+}
+
+// Close closes drivers of all created instances.
+func (p *pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return ErrClosed
+	}
+
+	p.closed = true
+
+	var errs []error
+
+	for _, inst := range p.instances {
+		for _, drv := range inst.Drivers().GetAll() {
+			if err := drv.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return core.Errors(errs...)
+	}
+
+	return nil
+}
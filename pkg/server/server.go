@@ -0,0 +1,318 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/runtime"
+)
+
+type (
+	// Request represents a body of POST /query requests.
+	Request struct {
+		Query  string                 `json:"query"`
+		Params map[string]interface{} `json:"params,omitempty"`
+		// Timeout is an execution timeout in milliseconds.
+		Timeout int64 `json:"timeout,omitempty"`
+		// Stream enables newline delimited JSON output, where each element of a resulting array is written
+		// and flushed as soon as it is produced by a top-level FOR expression.
+		Stream bool `json:"stream,omitempty"`
+	}
+
+	// ErrorResponse represents a body of failed requests.
+	ErrorResponse struct {
+		Kind  string `json:"kind"`
+		Error string `json:"error"`
+	}
+
+	// Server executes queries sent over HTTP.
+	Server struct {
+		opts  *Options
+		pool  *pool
+		cache *programCache
+		mux   *http.ServeMux
+	}
+)
+
+// Kinds of errors returned to clients.
+const (
+	ErrorKindRequest     = "request"
+	ErrorKindCompilation = "compilation"
+	ErrorKindRuntime     = "runtime"
+	ErrorKindTimeout     = "timeout"
+	ErrorKindUnavailable = "unavailable"
+)
+
+const contentTypeNDJSON = "application/x-ndjson"
+
+func New(factory Factory, setters ...Option) *Server {
+	opts := newOptions(setters)
+
+	s := &Server{
+		opts:  opts,
+		pool:  newPool(factory, opts.poolSize),
+		cache: newProgramCache(opts.cacheSize),
+		mux:   http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("/query", s.handleQuery)
+	s.mux.HandleFunc("/health", s.handleHealth)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Close closes drivers of all pooled instances.
+func (s *Server) Close() error {
+	return s.pool.Close()
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) handleQuery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, ErrorKindRequest, errors.New("method not allowed"))
+
+		return
+	}
+
+	req, err := s.decodeRequest(w, r)
+
+	if err != nil {
+		status := http.StatusBadRequest
+
+		if errors.Is(err, ErrTooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		writeError(w, status, ErrorKindRequest, err)
+
+		return
+	}
+
+	ctx := r.Context()
+
+	if timeout := s.timeout(req); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	inst, err := s.pool.Acquire(ctx)
+
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, ErrorKindUnavailable, err)
+
+		return
+	}
+
+	defer s.pool.Release(inst)
+
+	key := hashQuery(req.Query)
+	program, found := s.cache.Get(key)
+
+	if !found {
+		program, err = inst.Compile(req.Query)
+
+		if err != nil {
+			writeError(w, http.StatusBadRequest, ErrorKindCompilation, err)
+
+			return
+		}
+
+		s.cache.Set(key, program)
+	}
+
+	opts := make([]runtime.Option, 0, len(s.opts.runtimeOpts)+1)
+	opts = append(opts, s.opts.runtimeOpts...)
+	opts = append(opts, runtime.WithParams(req.Params))
+
+	if req.Stream || strings.Contains(r.Header.Get("Accept"), contentTypeNDJSON) {
+		s.writeStream(ctx, w, inst, program, opts)
+
+		return
+	}
+
+	out, err := inst.Run(ctx, program, opts...)
+
+	if err != nil {
+		writeRuntimeError(ctx, w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(out)
+}
+
+// decodeRequest reads a request from either a JSON body or a plain text body containing only a query.
+// Since a query never starts with a curly brace, such bodies are treated as JSON regardless of their content type.
+func (s *Server) decodeRequest(w http.ResponseWriter, r *http.Request) (Request, error) {
+	var req Request
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.opts.maxBodySize))
+
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+
+		if errors.As(err, &tooLarge) {
+			return req, errors.Wrapf(ErrTooLarge, "limit is %d bytes", tooLarge.Limit)
+		}
+
+		return req, errors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	if mediaType == "application/json" || bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")) {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&req); err != nil {
+			return req, errors.Wrap(ErrInvalidInput, err.Error())
+		}
+
+		for name, value := range req.Params {
+			req.Params[name] = normalize(value)
+		}
+	} else {
+		req.Query = string(body)
+	}
+
+	if strings.TrimSpace(req.Query) == "" {
+		return req, ErrMissedQuery
+	}
+
+	if req.Timeout < 0 {
+		return req, errors.Wrap(ErrInvalidInput, "negative timeout")
+	}
+
+	return req, nil
+}
+
+func (s *Server) timeout(req Request) time.Duration {
+	timeout := s.opts.timeout
+
+	if req.Timeout > 0 {
+		timeout = time.Duration(req.Timeout) * time.Millisecond
+	}
+
+	if s.opts.maxTimeout > 0 && (timeout == 0 || timeout > s.opts.maxTimeout) {
+		timeout = s.opts.maxTimeout
+	}
+
+	return timeout
+}
+
+// normalize converts JSON numbers to integers where possible, so params keep their types in queries.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+
+		f, _ := v.Float64()
+
+		return f
+	case []interface{}:
+		for i, el := range v {
+			v[i] = normalize(el)
+		}
+	case map[string]interface{}:
+		for key, el := range v {
+			v[key] = normalize(el)
+		}
+	}
+
+	return value
+}
+
+// writeStream writes elements of a result as newline delimited JSON, flushing each of them as soon as it is produced.
+// Errors occurred before the first element are reported with a status code as usual,
+// later ones are written as a last line containing an ErrorResponse.
+func (s *Server) writeStream(ctx context.Context, w http.ResponseWriter, inst *ferret.Instance, program *runtime.Program, opts []runtime.Option) {
+	flusher, _ := w.(http.Flusher)
+	started := false
+
+	err := inst.Stream(ctx, program, func(element []byte) error {
+		if !started {
+			w.Header().Set("Content-Type", contentTypeNDJSON)
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+
+		if _, err := w.Write(append(element, '\n')); err != nil {
+			return err
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		return nil
+	}, opts...)
+
+	if err == nil {
+		if !started {
+			w.Header().Set("Content-Type", contentTypeNDJSON)
+			w.WriteHeader(http.StatusOK)
+		}
+
+		return
+	}
+
+	if !started {
+		writeRuntimeError(ctx, w, err)
+
+		return
+	}
+
+	kind := ErrorKindRuntime
+
+	if ctx.Err() == context.DeadlineExceeded {
+		kind = ErrorKindTimeout
+	}
+
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Kind:  kind,
+		Error: err.Error(),
+	})
+}
+
+func writeRuntimeError(ctx context.Context, w http.ResponseWriter, err error) {
+	if ctx.Err() == context.DeadlineExceeded {
+		writeError(w, http.StatusGatewayTimeout, ErrorKindTimeout, err)
+	} else {
+		writeError(w, http.StatusUnprocessableEntity, ErrorKindRuntime, err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, kind string, err error) {
+	writeJSON(w, status, ErrorResponse{
+		Kind:  kind,
+		Error: err.Error(),
+	})
+}
@@ -0,0 +1,140 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret"
+	"github.com/MontFerret/ferret/pkg/server"
+)
+
+func post(s *server.Server, body string, contentType string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewBufferString(body))
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	return w
+}
+
+func decodeError(w *httptest.ResponseRecorder) server.ErrorResponse {
+	var out server.ErrorResponse
+
+	So(json.Unmarshal(w.Body.Bytes(), &out), ShouldBeNil)
+
+	return out
+}
+
+func TestServer(t *testing.T) {
+	var created int32
+
+	factory := func() (*ferret.Instance, error) {
+		atomic.AddInt32(&created, 1)
+
+		return ferret.New(), nil
+	}
+
+	Convey("Should execute a query with params", t, func() {
+		s := server.New(factory)
+		defer s.Close()
+
+		w := post(s, `{"query": "FOR i IN 1..@max RETURN i * @factor", "params": {"max": 3, "factor": 1.5}}`, "application/json")
+
+		So(w.Code, ShouldEqual, http.StatusOK)
+		So(w.Header().Get("Content-Type"), ShouldEqual, "application/json")
+		So(w.Body.String(), ShouldEqual, `[1.5,3,4.5]`)
+	})
+
+	Convey("Should accept plain text queries", t, func() {
+		s := server.New(factory)
+		defer s.Close()
+
+		w := post(s, `RETURN "foo"`, "text/plain")
+
+		So(w.Code, ShouldEqual, http.StatusOK)
+		So(w.Body.String(), ShouldEqual, `"foo"`)
+	})
+
+	Convey("Should stream arrays as newline delimited JSON", t, func() {
+		s := server.New(factory)
+		defer s.Close()
+
+		w := post(s, `{"query": "FOR i IN 1..3 RETURN { i }", "stream": true}`, "")
+
+		So(w.Code, ShouldEqual, http.StatusOK)
+		So(w.Header().Get("Content-Type"), ShouldEqual, "application/x-ndjson")
+		So(w.Body.String(), ShouldEqual, "{\"i\":1}\n{\"i\":2}\n{\"i\":3}\n")
+	})
+
+	Convey("Should report errors", t, func() {
+		s := server.New(factory)
+		defer s.Close()
+
+		w := post(s, `{"params": {}}`, "application/json")
+
+		So(w.Code, ShouldEqual, http.StatusBadRequest)
+		So(decodeError(w).Kind, ShouldEqual, server.ErrorKindRequest)
+
+		w = post(s, `{"query": "RETURN"}`, "application/json")
+
+		So(w.Code, ShouldEqual, http.StatusBadRequest)
+		So(decodeError(w).Kind, ShouldEqual, server.ErrorKindCompilation)
+
+		w = post(s, `{"query": "RETURN @foo"}`, "application/json")
+
+		So(w.Code, ShouldEqual, http.StatusUnprocessableEntity)
+		So(decodeError(w).Kind, ShouldEqual, server.ErrorKindRuntime)
+
+		req := httptest.NewRequest(http.MethodGet, "/query", nil)
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+
+		So(rec.Code, ShouldEqual, http.StatusMethodNotAllowed)
+	})
+
+	Convey("Should reject too large bodies", t, func() {
+		s := server.New(factory, server.WithMaxBodySize(16))
+		defer s.Close()
+
+		w := post(s, `RETURN "a long enough query"`, "")
+
+		So(w.Code, ShouldEqual, http.StatusRequestEntityTooLarge)
+		So(decodeError(w).Kind, ShouldEqual, server.ErrorKindRequest)
+	})
+
+	Convey("Should stop queries exceeding a timeout", t, func() {
+		s := server.New(factory, server.WithMaxTimeout(time.Second))
+		defer s.Close()
+
+		start := time.Now()
+		w := post(s, `{"query": "WAIT(5000) RETURN 1", "timeout": 50}`, "application/json")
+
+		So(time.Since(start), ShouldBeLessThan, time.Second)
+		So(w.Code, ShouldEqual, http.StatusGatewayTimeout)
+		So(decodeError(w).Kind, ShouldEqual, server.ErrorKindTimeout)
+	})
+
+	Convey("Should reuse pooled instances", t, func() {
+		atomic.StoreInt32(&created, 0)
+
+		s := server.New(factory, server.WithPoolSize(2))
+		defer s.Close()
+
+		for i := 0; i < 5; i++ {
+			So(post(s, `RETURN 1`, "").Code, ShouldEqual, http.StatusOK)
+		}
+
+		So(atomic.LoadInt32(&created), ShouldEqual, 1)
+	})
+}
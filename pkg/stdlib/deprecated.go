@@ -0,0 +1,8 @@
+package stdlib
+
+// DeprecatedFunctions maps names of deprecated functions of the standard library to their replacements.
+// An empty replacement means that a function has no replacement.
+// Functions listed here are reported by the linter unless its configuration says otherwise.
+// Renamed functions get listed here only while their old names are not taken by other functions,
+// e.g. LOG, renamed to PRINT, is a math function now.
+var DeprecatedFunctions = map[string]string{}
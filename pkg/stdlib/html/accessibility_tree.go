@@ -0,0 +1,47 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// ACCESSIBILITY_TREE returns a computed accessibility tree of a given page or element.
+// Each node is an object with role, name, value, description, states and children.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target page or element.
+// @param {Boolean} [interestingOnly=True] - If true, prunes nodes that are not interesting for assistive technologies.
+// @return {Object} - Root node of the tree.
+func AccessibilityTree(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 2)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	params := drivers.AccessibilityParams{
+		InterestingOnly: true,
+	}
+
+	if len(args) > 1 {
+		if err := core.ValidateType(args[1], types.Boolean); err != nil {
+			return values.None, err
+		}
+
+		params.InterestingOnly = bool(values.ToBoolean(args[1]))
+	}
+
+	if page, ok := args[0].(drivers.HTMLPage); ok {
+		return page.GetAccessibilityTree(ctx, params)
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return el.GetAccessibilityTree(ctx, params)
+}
@@ -0,0 +1,52 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// CLICK_RIGHT dispatches right button click event on a given element.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @param {Int} [clicks=1] - Count of clicks.
+// @return {Boolean} - True if the click was dispatched.
+func ClickRight(ctx context.Context, args ...core.Value) (core.Value, error) {
+	return clickWithButton(ctx, drivers.MouseButtonRight, args)
+}
+
+// CLICK_MIDDLE dispatches middle button click event on a given element.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @param {Int} [clicks=1] - Count of clicks.
+// @return {Boolean} - True if the click was dispatched.
+func ClickMiddle(ctx context.Context, args ...core.Value) (core.Value, error) {
+	return clickWithButton(ctx, drivers.MouseButtonMiddle, args)
+}
+
+func clickWithButton(ctx context.Context, button drivers.MouseButton, args []core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 2)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	count := values.NewInt(1)
+
+	if len(args) > 1 {
+		if err := core.ValidateType(args[1], types.Int); err != nil {
+			return values.False, err
+		}
+
+		count = values.ToInt(args[1])
+	}
+
+	return values.True, el.ClickWithButton(ctx, button, count)
+}
@@ -0,0 +1,40 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// COVERAGE_START starts collecting JavaScript coverage of a given page.
+// Coverage is returned by COVERAGE_STOP.
+// @param {HTMLPage} page - Target page.
+// @param {Boolean} [detailed=False] - If true, collects block coverage instead of function coverage.
+func CoverageStart(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 2)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	detailed := values.False
+
+	if len(args) > 1 {
+		if err := core.ValidateType(args[1], types.Boolean); err != nil {
+			return values.None, err
+		}
+
+		detailed = values.ToBoolean(args[1])
+	}
+
+	return values.None, page.StartCoverage(ctx, detailed)
+}
@@ -0,0 +1,30 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// COVERAGE_STOP stops collecting JavaScript coverage of a given page, started by COVERAGE_START.
+// Each script coverage is an object with scriptId, url and functions,
+// where every function has functionName, isBlockCoverage and ranges with startOffset, endOffset and count.
+// @param {HTMLPage} page - Target page.
+// @return {Object[]} - Coverage of scripts loaded by the page.
+func CoverageStop(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 1)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return page.StopCoverage(ctx)
+}
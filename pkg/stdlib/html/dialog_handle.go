@@ -0,0 +1,54 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// DIALOG_HANDLE accepts or dismisses a JavaScript dialog (alert, confirm, prompt or beforeunload) currently open on a given page.
+// Useful when a page is opened with dialog action "none", which leaves dialogs open until they get handled explicitly.
+// @param {HTMLPage} page - Target page.
+// @param {Boolean} [accept=True] - Boolean value indicating whether to accept or dismiss the dialog.
+// @param {String} [promptText] - Text to enter into a prompt dialog before accepting it.
+func DialogHandle(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 3)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	accept := values.True
+	promptText := values.EmptyString
+
+	if len(args) > 1 {
+		err = core.ValidateType(args[1], types.Boolean)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		accept = args[1].(values.Boolean)
+	}
+
+	if len(args) > 2 {
+		err = core.ValidateType(args[2], types.String)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		promptText = args[2].(values.String)
+	}
+
+	return values.None, page.HandleDialog(ctx, accept, promptText)
+}
@@ -51,6 +51,35 @@ type PageLoadParams struct {
 // @param {Boolean} [params.viewport.mobile] - Value that indicates whether to emulate mobile device.
 // @param {Boolean} [params.viewport.landscape] - Value that indicates whether to render a page in landscape position.
 // @param {String} [params.charset] - (only HTTPDriver) Source charset content to convert UTF-8.
+// @param {Object|String} [params.dialog] - (only CDPDriver) Policy of handling JavaScript dialogs. A string value is treated as an action.
+// @param {String} [params.dialog.action] - Action to apply to opened dialogs: "accept", "dismiss" or "none" to leave them open for DIALOG_HANDLE. By default, "beforeunload" dialogs are accepted and the others are dismissed.
+// @param {String} [params.dialog.promptText] - Text to enter into prompt dialogs before accepting them.
+// @param {Object|String} [params.console] - (only CDPDriver) Policy of forwarding page console messages and uncaught exceptions into the logger. A string value is treated as a level.
+// @param {String} [params.console.level] - Log level to use, e.g. "info" or "error". By default, the level is derived from a message type and uncaught exceptions are logged as errors.
+// @param {Object} [params.emulation] - (only CDPDriver) Device and environment emulation params.
+// @param {String} [params.emulation.device] - Name of a device preset, e.g. "iPhone 13" or "Pixel 5". Its viewport and user agent are used unless set explicitly.
+// @param {Object} [params.emulation.geolocation] - Geolocation to report.
+// @param {Float} params.emulation.geolocation.latitude - Latitude.
+// @param {Float} params.emulation.geolocation.longitude - Longitude.
+// @param {Float} [params.emulation.geolocation.accuracy=0] - Accuracy in meters.
+// @param {String} [params.emulation.timezone] - Timezone ID, e.g. "Europe/Berlin".
+// @param {String} [params.emulation.locale] - ICU style locale, e.g. "en_US".
+// @param {String} [params.emulation.colorScheme] - Preferred color scheme: "light", "dark" or "no-preference".
+// @param {Boolean} [params.emulation.reducedMotion=False] - Boolean value indicating whether to prefer reduced motion.
+// @param {Boolean} [params.emulation.touch=False] - Boolean value indicating whether to enable touch events.
+// @param {Float} [params.emulation.cpuThrottling] - CPU slowdown factor, e.g. 4 makes CPU 4 times slower.
+// @param {Object} [params.emulation.network] - Network conditions.
+// @param {Boolean} [params.emulation.network.offline=False] - Boolean value indicating whether to emulate internet disconnection.
+// @param {Float} [params.emulation.network.latency=0] - Minimum latency in milliseconds.
+// @param {Float} [params.emulation.network.downloadThroughput] - Maximal download throughput in bytes per second.
+// @param {Float} [params.emulation.network.uploadThroughput] - Maximal upload throughput in bytes per second.
+// @param {Object} [params.storage] - (only CDPDriver) Web storage items to set for the page origin before loading the page.
+// @param {Object} [params.storage.local] - localStorage items.
+// @param {Object} [params.storage.session] - sessionStorage items.
+// @param {Object[]} [params.intercept] - (only CDPDriver) Collection of rules for modifying requests or mocking responses. See INTERCEPT for details.
+// @param {Boolean|Object} [params.har] - (only CDPDriver) Enables recording of network traffic that can be retrieved by HAR function.
+// @param {Boolean} [params.har.content=False] - Boolean value indicating whether to record response bodies.
+// @param {String} [params.inputProfile] - (only CDPDriver) Input simulation profile. "human" moves the mouse along curved paths and types with a variable pace and occasional typos.
 // @return {HTMLPage} - Loaded HTML page.
 func Open(ctx context.Context, args ...core.Value) (core.Value, error) {
 	err := core.ValidateArgs(args, 1, 2)
@@ -81,6 +110,10 @@ func Open(ctx context.Context, args ...core.Value) (core.Value, error) {
 		params = p
 	}
 
+	return openPage(ctx, params)
+}
+
+func openPage(ctx context.Context, params PageLoadParams) (core.Value, error) {
 	ctx, cancel := context.WithTimeout(ctx, params.Timeout)
 	defer cancel()
 
@@ -226,6 +259,90 @@ func newPageLoadParams(url values.String, arg core.Value) (PageLoadParams, error
 
 			res.Charset = charset.String()
 		}
+
+		dialog, exists := obj.Get(values.NewString("dialog"))
+
+		if exists {
+			dialog, err := parseDialog(dialog)
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Dialog = dialog
+		}
+
+		console, exists := obj.Get(values.NewString("console"))
+
+		if exists {
+			console, err := parseConsole(console)
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Console = console
+		}
+
+		emulation, exists := obj.Get(values.NewString("emulation"))
+
+		if exists {
+			emulation, err := parseEmulation(emulation)
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Emulation = emulation
+		}
+
+		storage, exists := obj.Get(values.NewString("storage"))
+
+		if exists {
+			storage, err := parseStorage(storage)
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Storage = storage
+		}
+
+		intercept, exists := obj.Get(values.NewString("intercept"))
+
+		if exists {
+			rules, err := parseInterceptRules(intercept)
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Intercept = rules
+		}
+
+		har, exists := obj.Get(values.NewString("har"))
+
+		if exists {
+			har, err := parseHAR(har)
+
+			if err != nil {
+				return res, err
+			}
+
+			res.HAR = har
+		}
+
+		inputProfile, exists := obj.Get(values.NewString("inputProfile"))
+
+		if exists {
+			inputProfile, err := parseInputProfile(inputProfile)
+
+			if err != nil {
+				return res, err
+			}
+
+			res.InputProfile = inputProfile
+		}
 	case types.String:
 		res.Driver = arg.(values.String).String()
 	case types.Boolean:
@@ -313,11 +430,12 @@ func parseCookie(value core.Value) (drivers.HTTPCookie, error) {
 	maxAge, exists := co.Get("maxAge")
 
 	if exists {
-		if err = core.ValidateType(maxAge, types.Int); err != nil {
+		// numbers restored from JSON are floats
+		if err = core.ValidateType(maxAge, types.Int, types.Float); err != nil {
 			return drivers.HTTPCookie{}, err
 		}
 
-		cookie.MaxAge = int(maxAge.(values.Int))
+		cookie.MaxAge = int(values.ToInt(maxAge))
 	}
 
 	expires, exists := co.Get("expires")
@@ -454,6 +572,272 @@ func parseViewport(value core.Value) (*drivers.Viewport, error) {
 	return res, nil
 }
 
+func parseDialog(value core.Value) (*drivers.Dialog, error) {
+	if err := core.ValidateType(value, types.Object, types.String); err != nil {
+		return nil, err
+	}
+
+	res := &drivers.Dialog{}
+
+	if value.Type() == types.String {
+		res.Action = drivers.DialogAction(value.String())
+	} else {
+		dialog := value.(*values.Object)
+
+		action, exists := dialog.Get(values.NewString("action"))
+
+		if exists {
+			if err := core.ValidateType(action, types.String); err != nil {
+				return nil, err
+			}
+
+			res.Action = drivers.DialogAction(action.String())
+		}
+
+		promptText, exists := dialog.Get(values.NewString("promptText"))
+
+		if exists {
+			if err := core.ValidateType(promptText, types.String); err != nil {
+				return nil, err
+			}
+
+			res.PromptText = promptText.String()
+		}
+	}
+
+	if !drivers.IsDialogActionValid(string(res.Action)) {
+		return nil, core.Errorf(core.ErrInvalidArgument, "dialog action: %s", res.Action)
+	}
+
+	return res, nil
+}
+
+func parseConsole(value core.Value) (*drivers.Console, error) {
+	if err := core.ValidateType(value, types.Object, types.String); err != nil {
+		return nil, err
+	}
+
+	res := &drivers.Console{}
+
+	if value.Type() == types.String {
+		res.Level = value.String()
+	} else {
+		console := value.(*values.Object)
+
+		level, exists := console.Get(values.NewString("level"))
+
+		if exists {
+			if err := core.ValidateType(level, types.String); err != nil {
+				return nil, err
+			}
+
+			res.Level = level.String()
+		}
+	}
+
+	if !drivers.IsConsoleLevelValid(res.Level) {
+		return nil, core.Errorf(core.ErrInvalidArgument, "console level: %s", res.Level)
+	}
+
+	return res, nil
+}
+
+func parseEmulation(value core.Value) (*drivers.Emulation, error) {
+	if err := core.ValidateType(value, types.Object); err != nil {
+		return nil, err
+	}
+
+	res := &drivers.Emulation{}
+
+	emulation := value.(*values.Object)
+
+	device, exists := emulation.Get(values.NewString("device"))
+
+	if exists {
+		if err := core.ValidateType(device, types.String); err != nil {
+			return nil, err
+		}
+
+		if _, found := drivers.GetDevice(device.String()); !found {
+			return nil, core.Errorf(core.ErrNotFound, "device: %s", device)
+		}
+
+		res.Device = device.String()
+	}
+
+	geolocation, exists := emulation.Get(values.NewString("geolocation"))
+
+	if exists {
+		if err := core.ValidateType(geolocation, types.Object); err != nil {
+			return nil, err
+		}
+
+		geo := geolocation.(*values.Object)
+
+		if !geo.Has("latitude") || !geo.Has("longitude") {
+			return nil, errors.Wrap(core.ErrMissedArgument, "geolocation coordinates")
+		}
+
+		res.Geolocation = &drivers.Geolocation{
+			Latitude:  float64(values.ToFloat(geo.MustGetOr("latitude", values.ZeroFloat))),
+			Longitude: float64(values.ToFloat(geo.MustGetOr("longitude", values.ZeroFloat))),
+			Accuracy:  float64(values.ToFloat(geo.MustGetOr("accuracy", values.ZeroFloat))),
+		}
+	}
+
+	timezone, exists := emulation.Get(values.NewString("timezone"))
+
+	if exists {
+		if err := core.ValidateType(timezone, types.String); err != nil {
+			return nil, err
+		}
+
+		res.Timezone = timezone.String()
+	}
+
+	locale, exists := emulation.Get(values.NewString("locale"))
+
+	if exists {
+		if err := core.ValidateType(locale, types.String); err != nil {
+			return nil, err
+		}
+
+		res.Locale = locale.String()
+	}
+
+	colorScheme, exists := emulation.Get(values.NewString("colorScheme"))
+
+	if exists {
+		if err := core.ValidateType(colorScheme, types.String); err != nil {
+			return nil, err
+		}
+
+		if !drivers.IsColorSchemeValid(colorScheme.String()) {
+			return nil, core.Errorf(core.ErrInvalidArgument, "color scheme: %s", colorScheme)
+		}
+
+		res.ColorScheme = drivers.ColorScheme(colorScheme.String())
+	}
+
+	reducedMotion, exists := emulation.Get(values.NewString("reducedMotion"))
+
+	if exists {
+		res.ReducedMotion = bool(values.ToBoolean(reducedMotion))
+	}
+
+	touch, exists := emulation.Get(values.NewString("touch"))
+
+	if exists {
+		res.Touch = bool(values.ToBoolean(touch))
+	}
+
+	cpuThrottling, exists := emulation.Get(values.NewString("cpuThrottling"))
+
+	if exists {
+		if err := core.ValidateType(cpuThrottling, types.Int, types.Float); err != nil {
+			return nil, err
+		}
+
+		res.CPUThrottling = float64(values.ToFloat(cpuThrottling))
+	}
+
+	network, exists := emulation.Get(values.NewString("network"))
+
+	if exists {
+		if err := core.ValidateType(network, types.Object); err != nil {
+			return nil, err
+		}
+
+		conditions := network.(*values.Object)
+
+		res.Network = &drivers.NetworkConditions{
+			Offline:            bool(values.ToBoolean(conditions.MustGetOr("offline", values.False))),
+			Latency:            float64(values.ToFloat(conditions.MustGetOr("latency", values.ZeroFloat))),
+			DownloadThroughput: float64(values.ToFloat(conditions.MustGetOr("downloadThroughput", values.ZeroFloat))),
+			UploadThroughput:   float64(values.ToFloat(conditions.MustGetOr("uploadThroughput", values.ZeroFloat))),
+		}
+	}
+
+	return res, nil
+}
+
+func parseStorage(value core.Value) (*drivers.Storage, error) {
+	if err := core.ValidateType(value, types.Object); err != nil {
+		return nil, err
+	}
+
+	res := &drivers.Storage{}
+
+	storage := value.(*values.Object)
+
+	local, exists := storage.Get(values.NewString("local"))
+
+	if exists {
+		items, err := parseStorageItems(local)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Local = items
+	}
+
+	session, exists := storage.Get(values.NewString("session"))
+
+	if exists {
+		items, err := parseStorageItems(session)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Session = items
+	}
+
+	return res, nil
+}
+
+func parseStorageItems(value core.Value) (map[string]string, error) {
+	if err := core.ValidateType(value, types.Object); err != nil {
+		return nil, err
+	}
+
+	obj := value.(*values.Object)
+	res := make(map[string]string, obj.Length())
+
+	obj.ForEach(func(value core.Value, key string) bool {
+		res[key] = value.String()
+
+		return true
+	})
+
+	return res, nil
+}
+
+func parseHAR(value core.Value) (*drivers.HARParams, error) {
+	if err := core.ValidateType(value, types.Boolean, types.Object); err != nil {
+		return nil, err
+	}
+
+	if value.Type() == types.Boolean {
+		if !value.(values.Boolean) {
+			return nil, nil
+		}
+
+		return &drivers.HARParams{}, nil
+	}
+
+	res := &drivers.HARParams{}
+
+	content, exists := value.(*values.Object).Get(values.NewString("content"))
+
+	if exists {
+		res.Content = bool(values.ToBoolean(content))
+	}
+
+	return res, nil
+}
+
 func parseIgnore(value core.Value) (*drivers.Ignore, error) {
 	if err := core.ValidateType(value, types.Object); err != nil {
 		return nil, err
@@ -544,3 +928,17 @@ func parseIgnore(value core.Value) (*drivers.Ignore, error) {
 
 	return res, nil
 }
+
+func parseInputProfile(value core.Value) (drivers.InputProfile, error) {
+	if err := core.ValidateType(value, types.String); err != nil {
+		return drivers.InputProfileDefault, err
+	}
+
+	profile := value.String()
+
+	if !drivers.IsInputProfileValid(profile) {
+		return drivers.InputProfileDefault, core.Errorf(core.ErrInvalidArgument, "input profile: %s", profile)
+	}
+
+	return drivers.InputProfile(profile), nil
+}
@@ -0,0 +1,35 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// DRAG drags a given element and drops it on a target element.
+// @param {HTMLElement} source - Element to drag.
+// @param {HTMLElement} target - Element to drop on.
+// @return {Boolean} - True if the element was dropped.
+func Drag(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 2)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	source, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	target, err := drivers.ToElement(args[1])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	return values.True, source.DragTo(ctx, target)
+}
@@ -0,0 +1,53 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// EVAL executes a given JavaScript expression in a context of a page, document or element and returns its result.
+// The expression can be either a function or a function body with access to arguments via arg1...argN.
+// If a target is an element, the element is passed as the first argument.
+// Elements passed as arguments or returned by the expression are passed by reference.
+// @param {HTMLPage | HTMLDocument | HTMLElement} target - Target node.
+// @param {String} expression - JavaScript expression.
+// @param {Any} [args...] - Arguments passed to the expression.
+// @return {Any} - Result of the expression. Promises are awaited.
+func Eval(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, core.MaxArgs)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	err = core.ValidateType(args[1], types.String)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	expression := values.ToString(args[1])
+
+	switch target := args[0].(type) {
+	case drivers.HTMLPage, drivers.HTMLDocument:
+		doc, err := drivers.ToDocument(target)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		return doc.Evaluate(ctx, expression, args[2:]...)
+	default:
+		el, err := drivers.ToElement(target)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		return el.Evaluate(ctx, expression, args[2:]...)
+	}
+}
@@ -0,0 +1,30 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// HAR returns network traffic of a given page in HAR 1.2 format.
+// The page must be opened with the "har" param, e.g. DOCUMENT(url, { driver: "cdp", har: true }).
+// To save it into a file, use IO::FS::WRITE(path, JSON_STRINGIFY(HAR(page))).
+// @param {HTMLPage} page - Target page.
+// @return {Object} - HAR object.
+func HAR(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 1)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return page.GetHAR(ctx)
+}
@@ -0,0 +1,40 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// HOLD presses the left mouse button over a given element and releases it after a given duration.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @param {Int} [duration=1000] - Duration in ms.
+// @return {Boolean} - True if the gesture was dispatched.
+func Hold(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 2)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	duration := values.NewInt(drivers.DefaultHoldDuration)
+
+	if len(args) > 1 {
+		if err := core.ValidateType(args[1], types.Int); err != nil {
+			return values.False, err
+		}
+
+		duration = values.ToInt(args[1])
+	}
+
+	return values.True, el.Hold(ctx, duration)
+}
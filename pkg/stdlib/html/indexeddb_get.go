@@ -0,0 +1,40 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// INDEXEDDB_GET gets all entries of an object store of an IndexedDB database of a given page.
+// IndexedDB databases are read-only.
+// @param {HTMLPage} page - Target page.
+// @param {String} database - Database name.
+// @param {String} store - Object store name.
+// @return {Object[]} - Store entries with "key", "primaryKey" and "value" fields.
+func IndexedDBGet(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 3, 3)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	if err := core.ValidateType(args[1], types.String); err != nil {
+		return values.None, err
+	}
+
+	if err := core.ValidateType(args[2], types.String); err != nil {
+		return values.None, err
+	}
+
+	return page.GetIndexedDBItems(ctx, values.ToString(args[1]), values.ToString(args[2]))
+}
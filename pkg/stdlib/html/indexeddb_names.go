@@ -0,0 +1,28 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// INDEXEDDB_NAMES returns names of IndexedDB databases of a given page.
+// @param {HTMLPage} page - Target page.
+// @return {String[]} - Database names.
+func IndexedDBNames(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 1)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return page.GetIndexedDBNames(ctx)
+}
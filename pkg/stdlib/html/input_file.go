@@ -0,0 +1,80 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// INPUT_FILE attaches files to an underlying input[type=file] element.
+// Binary values are written to temporary files, which are removed when the page gets closed.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @param {String} [selector] - CSS selector.
+// @param {String | Binary | Any[]} files - File path, file content or an array of them.
+// @return {Boolean} - Returns true if an element was found.
+func InputFile(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 3)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	// INPUT_FILE(el, files)
+	if len(args) == 2 {
+		files, err := toFiles(args[1])
+
+		if err != nil {
+			return values.False, err
+		}
+
+		return values.True, el.SetFiles(ctx, files)
+	}
+
+	// INPUT_FILE(el, selector, files)
+	selector, err := drivers.ToQuerySelector(args[1])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	files, err := toFiles(args[2])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	exists, err := el.ExistsBySelector(ctx, selector)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	if !exists {
+		return values.False, nil
+	}
+
+	return values.True, el.SetFilesBySelector(ctx, selector, files)
+}
+
+func toFiles(value core.Value) (*values.Array, error) {
+	err := core.ValidateType(value, types.String, types.Binary, types.Array)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if value.Type() == types.Array {
+		return value.(*values.Array), nil
+	}
+
+	return values.NewArrayWith(value), nil
+}
@@ -0,0 +1,276 @@
+package html
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// INTERCEPT adds rules for handling requests of a given page.
+// Matching requests are either modified or fulfilled with a mocked response without reaching a server.
+// Rules added later take precedence over the earlier ones.
+// @param {HTMLPage} page - Target page.
+// @param {Object | Object[]} rules - Rule or a list of rules.
+// @param {String} [rules.*.url] - Request url pattern. Wildcards ('*' -> zero or more, '?' -> exactly one) are allowed. Omitting is equivalent to "*".
+// @param {String} [rules.*.type] - Resource type, e.g. "document", "xhr" or "image".
+// @param {Object} [rules.*.request] - Request modifications.
+// @param {String} [rules.*.request.url] - New request url.
+// @param {String} [rules.*.request.method] - New request method.
+// @param {Object} [rules.*.request.headers] - Headers to add or override.
+// @param {String | Binary | Object} [rules.*.request.body] - New request body. Objects and arrays are sent as JSON.
+// @param {Object} [rules.*.response] - Mocked response.
+// @param {Int} [rules.*.response.status=200] - Response status code.
+// @param {Object} [rules.*.response.headers] - Response headers.
+// @param {String | Binary | Object} [rules.*.response.body] - Response body. Objects and arrays are sent as JSON.
+// @param {String} [rules.*.response.file] - Path to a file to use as a response body.
+func Intercept(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 2)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	rules, err := parseInterceptRules(args[1])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return values.None, page.Intercept(ctx, rules...)
+}
+
+func parseInterceptRules(value core.Value) ([]drivers.InterceptRule, error) {
+	if err := core.ValidateType(value, types.Object, types.Array); err != nil {
+		return nil, err
+	}
+
+	if value.Type() == types.Object {
+		rule, err := parseInterceptRule(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []drivers.InterceptRule{rule}, nil
+	}
+
+	arr := value.(*values.Array)
+	res := make([]drivers.InterceptRule, 0, arr.Length())
+
+	var err error
+
+	arr.ForEach(func(value core.Value, _ int) bool {
+		rule, e := parseInterceptRule(value)
+
+		if e != nil {
+			err = e
+
+			return false
+		}
+
+		res = append(res, rule)
+
+		return true
+	})
+
+	return res, err
+}
+
+func parseInterceptRule(value core.Value) (drivers.InterceptRule, error) {
+	res := drivers.InterceptRule{}
+
+	if err := core.ValidateType(value, types.Object); err != nil {
+		return res, err
+	}
+
+	rule := value.(*values.Object)
+
+	url, exists := rule.Get("url")
+
+	if exists {
+		if err := core.ValidateType(url, types.String); err != nil {
+			return res, err
+		}
+
+		res.URL = url.String()
+	}
+
+	resourceType, exists := rule.Get("type")
+
+	if exists {
+		if err := core.ValidateType(resourceType, types.String); err != nil {
+			return res, err
+		}
+
+		res.ResourceType = resourceType.String()
+	}
+
+	request, exists := rule.Get("request")
+
+	if exists {
+		req, err := parseInterceptRequest(request)
+
+		if err != nil {
+			return res, err
+		}
+
+		res.Request = req
+	}
+
+	response, exists := rule.Get("response")
+
+	if exists {
+		resp, err := parseInterceptResponse(response)
+
+		if err != nil {
+			return res, err
+		}
+
+		res.Response = resp
+	}
+
+	if res.Request == nil && res.Response == nil {
+		return res, errors.Wrap(core.ErrMissedArgument, "intercept rule request or response")
+	}
+
+	return res, nil
+}
+
+func parseInterceptRequest(value core.Value) (*drivers.InterceptRequest, error) {
+	if err := core.ValidateType(value, types.Object); err != nil {
+		return nil, err
+	}
+
+	res := &drivers.InterceptRequest{}
+
+	request := value.(*values.Object)
+
+	url, exists := request.Get("url")
+
+	if exists {
+		if err := core.ValidateType(url, types.String); err != nil {
+			return nil, err
+		}
+
+		res.URL = url.String()
+	}
+
+	method, exists := request.Get("method")
+
+	if exists {
+		if err := core.ValidateType(method, types.String); err != nil {
+			return nil, err
+		}
+
+		res.Method = method.String()
+	}
+
+	headers, exists := request.Get("headers")
+
+	if exists {
+		if err := core.ValidateType(headers, types.Object); err != nil {
+			return nil, err
+		}
+
+		res.Headers = parseHeader(headers.(*values.Object))
+	}
+
+	body, exists := request.Get("body")
+
+	if exists {
+		b, err := toInterceptBody(body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Body = b
+	}
+
+	return res, nil
+}
+
+func parseInterceptResponse(value core.Value) (*drivers.InterceptResponse, error) {
+	if err := core.ValidateType(value, types.Object); err != nil {
+		return nil, err
+	}
+
+	res := &drivers.InterceptResponse{}
+
+	response := value.(*values.Object)
+
+	status, exists := response.Get("status")
+
+	if exists {
+		if err := core.ValidateType(status, types.Int); err != nil {
+			return nil, err
+		}
+
+		res.StatusCode = int(status.(values.Int))
+	}
+
+	headers, exists := response.Get("headers")
+
+	if exists {
+		if err := core.ValidateType(headers, types.Object); err != nil {
+			return nil, err
+		}
+
+		res.Headers = parseHeader(headers.(*values.Object))
+	}
+
+	body, exists := response.Get("body")
+
+	if exists {
+		b, err := toInterceptBody(body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Body = b
+	}
+
+	file, exists := response.Get("file")
+
+	if exists {
+		if err := core.ValidateType(file, types.String); err != nil {
+			return nil, err
+		}
+
+		b, err := os.ReadFile(file.String())
+
+		if err != nil {
+			return nil, errors.Wrap(err, "read response body file")
+		}
+
+		res.Body = b
+	}
+
+	return res, nil
+}
+
+func toInterceptBody(value core.Value) ([]byte, error) {
+	switch v := value.(type) {
+	case values.String:
+		return []byte(v), nil
+	case values.Binary:
+		return []byte(v), nil
+	case *values.Object, *values.Array:
+		return v.MarshalJSON()
+	default:
+		return nil, core.TypeError(value.Type(), types.String, types.Binary, types.Object, types.Array)
+	}
+}
@@ -13,69 +13,97 @@ import (
 func RegisterLib(ns core.Namespace) error {
 	return ns.RegisterFunctions(
 		core.NewFunctionsFromMap(map[string]core.Function{
-			"ATTR_GET":          AttributeGet,
-			"ATTR_QUERY":        AttributeQuery,
-			"ATTR_REMOVE":       AttributeRemove,
-			"ATTR_SET":          AttributeSet,
-			"BLUR":              Blur,
-			"COOKIE_DEL":        CookieDel,
-			"COOKIE_GET":        CookieGet,
-			"COOKIE_SET":        CookieSet,
-			"CLICK":             Click,
-			"CLICK_ALL":         ClickAll,
-			"DOCUMENT":          Open,
-			"DOCUMENT_EXISTS":   DocumentExists,
-			"DOWNLOAD":          Download,
-			"ELEMENT":           Element,
-			"ELEMENT_EXISTS":    ElementExists,
-			"ELEMENTS":          Elements,
-			"ELEMENTS_COUNT":    ElementsCount,
-			"FRAMES":            Frames,
-			"FOCUS":             Focus,
-			"HOVER":             Hover,
-			"INNER_HTML":        GetInnerHTML,
-			"INNER_HTML_SET":    SetInnerHTML,
-			"INNER_HTML_ALL":    GetInnerHTMLAll,
-			"INNER_TEXT":        GetInnerText,
-			"INNER_TEXT_SET":    SetInnerText,
-			"INNER_TEXT_ALL":    GetInnerTextAll,
-			"INPUT":             Input,
-			"INPUT_CLEAR":       InputClear,
-			"MOUSE":             MouseMoveXY,
-			"NAVIGATE":          Navigate,
-			"NAVIGATE_BACK":     NavigateBack,
-			"NAVIGATE_FORWARD":  NavigateForward,
-			"PAGINATION":        Pagination,
-			"PARSE":             Parse,
-			"PDF":               PDF,
-			"PRESS":             Press,
-			"PRESS_SELECTOR":    PressSelector,
-			"SCREENSHOT":        Screenshot,
-			"SCROLL":            ScrollXY,
-			"SCROLL_BOTTOM":     ScrollBottom,
-			"SCROLL_ELEMENT":    ScrollInto,
-			"SCROLL_TOP":        ScrollTop,
-			"SELECT":            Select,
-			"STYLE_GET":         StyleGet,
-			"STYLE_REMOVE":      StyleRemove,
-			"STYLE_SET":         StyleSet,
-			"WAIT_ATTR":         WaitAttribute,
-			"WAIT_NO_ATTR":      WaitNoAttribute,
-			"WAIT_ATTR_ALL":     WaitAttributeAll,
-			"WAIT_NO_ATTR_ALL":  WaitNoAttributeAll,
-			"WAIT_ELEMENT":      WaitElement,
-			"WAIT_NO_ELEMENT":   WaitNoElement,
-			"WAIT_CLASS":        WaitClass,
-			"WAIT_NO_CLASS":     WaitNoClass,
-			"WAIT_CLASS_ALL":    WaitClassAll,
-			"WAIT_NO_CLASS_ALL": WaitNoClassAll,
-			"WAIT_STYLE":        WaitStyle,
-			"WAIT_NO_STYLE":     WaitNoStyle,
-			"WAIT_STYLE_ALL":    WaitStyleAll,
-			"WAIT_NO_STYLE_ALL": WaitNoStyleAll,
-			"WAIT_NAVIGATION":   WaitNavigation,
-			"XPATH":             XPath,
-			"X":                 XPathSelector,
+			"ACCESSIBILITY_TREE": AccessibilityTree,
+			"ATTR_GET":           AttributeGet,
+			"ATTR_QUERY":         AttributeQuery,
+			"ATTR_REMOVE":        AttributeRemove,
+			"ATTR_SET":           AttributeSet,
+			"BLUR":               Blur,
+			"COOKIE_DEL":         CookieDel,
+			"COOKIE_GET":         CookieGet,
+			"COOKIE_SET":         CookieSet,
+			"COVERAGE_START":     CoverageStart,
+			"COVERAGE_STOP":      CoverageStop,
+			"CLICK":              Click,
+			"CLICK_ALL":          ClickAll,
+			"CLICK_MIDDLE":       ClickMiddle,
+			"CLICK_RIGHT":        ClickRight,
+			"DIALOG_HANDLE":      DialogHandle,
+			"DOCUMENT":           Open,
+			"DOCUMENT_EXISTS":    DocumentExists,
+			"DOWNLOAD":           Download,
+			"DRAG":               Drag,
+			"ELEMENT":            Element,
+			"ELEMENT_EXISTS":     ElementExists,
+			"ELEMENTS":           Elements,
+			"ELEMENTS_COUNT":     ElementsCount,
+			"EVAL":               Eval,
+			"FRAMES":             Frames,
+			"FOCUS":              Focus,
+			"HAR":                HAR,
+			"HOLD":               Hold,
+			"HOVER":              Hover,
+			"INDEXEDDB_GET":      IndexedDBGet,
+			"INDEXEDDB_NAMES":    IndexedDBNames,
+			"INNER_HTML":         GetInnerHTML,
+			"INNER_HTML_SET":     SetInnerHTML,
+			"INNER_HTML_ALL":     GetInnerHTMLAll,
+			"INNER_TEXT":         GetInnerText,
+			"INNER_TEXT_SET":     SetInnerText,
+			"INNER_TEXT_ALL":     GetInnerTextAll,
+			"INPUT":              Input,
+			"INPUT_CLEAR":        InputClear,
+			"INPUT_FILE":         InputFile,
+			"INTERCEPT":          Intercept,
+			"METRICS":            Metrics,
+			"MOUSE":              MouseMoveXY,
+			"NAVIGATE":           Navigate,
+			"NAVIGATE_BACK":      NavigateBack,
+			"NAVIGATE_FORWARD":   NavigateForward,
+			"PAGES":              Pages,
+			"PAGINATION":         Pagination,
+			"PARSE":              Parse,
+			"PDF":                PDF,
+			"PINCH":              Pinch,
+			"PRESS":              Press,
+			"PRESS_SELECTOR":     PressSelector,
+			"SCREENSHOT":         Screenshot,
+			"SCROLL":             ScrollXY,
+			"SCROLL_BOTTOM":      ScrollBottom,
+			"SCROLL_ELEMENT":     ScrollInto,
+			"SCROLL_TOP":         ScrollTop,
+			"SELECT":             Select,
+			"SESSION_LOAD":       SessionLoad,
+			"SESSION_SAVE":       SessionSave,
+			"SHADOW":             ShadowSelector,
+			"STORAGE_CLEAR":      StorageClear,
+			"STORAGE_GET":        StorageGet,
+			"STORAGE_SET":        StorageSet,
+			"STYLE_GET":          StyleGet,
+			"STYLE_REMOVE":       StyleRemove,
+			"STYLE_SET":          StyleSet,
+			"SWIPE":              Swipe,
+			"SWITCH_TAB":         SwitchTab,
+			"TAP":                Tap,
+			"WAIT_ATTR":          WaitAttribute,
+			"WAIT_NO_ATTR":       WaitNoAttribute,
+			"WAIT_ATTR_ALL":      WaitAttributeAll,
+			"WAIT_NO_ATTR_ALL":   WaitNoAttributeAll,
+			"WAIT_ELEMENT":       WaitElement,
+			"WAIT_NO_ELEMENT":    WaitNoElement,
+			"WAIT_CLASS":         WaitClass,
+			"WAIT_NO_CLASS":      WaitNoClass,
+			"WAIT_CLASS_ALL":     WaitClassAll,
+			"WAIT_NO_CLASS_ALL":  WaitNoClassAll,
+			"WAIT_STYLE":         WaitStyle,
+			"WAIT_NO_STYLE":      WaitNoStyle,
+			"WAIT_STYLE_ALL":     WaitStyleAll,
+			"WAIT_NO_STYLE_ALL":  WaitNoStyleAll,
+			"WAIT_NAVIGATION":    WaitNavigation,
+			"WAIT_RESPONSE":      WaitResponse,
+			"WHEEL":              Wheel,
+			"XPATH":              XPath,
+			"X":                  XPathSelector,
 		}))
 }
 
@@ -112,6 +140,24 @@ func waitTimeout(ctx context.Context, value values.Int) (context.Context, contex
 	)
 }
 
+func toStorageType(args []core.Value, idx int) (drivers.StorageType, error) {
+	if len(args) <= idx {
+		return drivers.StorageTypeLocal, nil
+	}
+
+	if err := core.ValidateType(args[idx], types.String); err != nil {
+		return drivers.StorageTypeLocal, err
+	}
+
+	storageType := args[idx].String()
+
+	if !drivers.IsStorageTypeValid(storageType) {
+		return drivers.StorageTypeLocal, core.Errorf(core.ErrInvalidArgument, "storage type: %s", storageType)
+	}
+
+	return drivers.StorageType(storageType), nil
+}
+
 func toScrollOptions(value core.Value) (drivers.ScrollOptions, error) {
 	result := drivers.ScrollOptions{}
 
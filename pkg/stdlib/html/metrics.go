@@ -0,0 +1,30 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// METRICS returns performance metrics of a given page.
+// Web Vitals that have not been observed yet (e.g. fid before any user input) are None.
+// Runtime metrics are also emitted as a "metrics" event every time the page calls console.timeStamp.
+// @param {HTMLPage} page - Target page.
+// @return {Object} - Object with runtime metrics, navigation timing, Web Vitals (fcp, lcp, cls, fid and ttfb) and resource timings.
+func Metrics(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 1)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return page.GetMetrics(ctx)
+}
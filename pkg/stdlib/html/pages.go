@@ -0,0 +1,29 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// PAGES returns a given page and all pages (tabs and popups) opened by it.
+// Child pages get closed along with the page that opened them.
+// @param {HTMLPage} page - Target page.
+// @return {HTMLPage[]} - Array of pages, where the first one is the target page.
+func Pages(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 1)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return page.GetPages(ctx)
+}
@@ -0,0 +1,40 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// PINCH dispatches two finger pinch gesture over a given element.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @param {Int | Float} scale - Scale factor. Values greater than 1 zoom in, values less than 1 zoom out.
+// @return {Boolean} - True if the gesture was dispatched.
+func Pinch(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 2)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	if err := core.ValidateType(args[1], types.Int, types.Float); err != nil {
+		return values.False, err
+	}
+
+	scale := values.ToFloat(args[1])
+
+	if scale <= 0 {
+		return values.False, core.Errorf(core.ErrInvalidArgument, "scale must be positive: %s", scale)
+	}
+
+	return values.True, el.Pinch(ctx, scale)
+}
@@ -10,15 +10,17 @@ import (
 	"github.com/MontFerret/ferret/pkg/runtime/values/types"
 )
 
-// SCREENSHOT takes a screenshot of a given page.
-// @param {HTMLPage|String} target - Target page or url.
+// SCREENSHOT takes a screenshot of a given page or element.
+// @param {HTMLPage|HTMLElement|String} target - Target page, element or url. Clip params are ignored for elements.
 // @param {Object} [params] - An object containing the following properties :
 // @param {Float | Int} [params.x=0] - X position of the viewport.
 // @param {Float | Int} [params.y=0] - Y position of the viewport.
 // @param {Float | Int} [params.width] - Width of the viewport.
 // @param {Float | Int} [params.height] - Height of the viewport.
-// @param {String} [params.format="jpeg"] - Either "jpeg" or "png".
-// @param {Int} [params.quality=100] - Quality, in [0, 100], only for jpeg format.
+// @param {String} [params.format="jpeg"] - Either "jpeg", "png" or "webp".
+// @param {Int} [params.quality=100] - Quality, in [0, 100], only for jpeg and webp formats.
+// @param {Boolean} [params.fullPage=False] - Capture the whole scrollable page instead of the viewport.
+// @param {Boolean} [params.omitBackground=False] - Make the default white background transparent, only for png and webp formats.
 // @return {Binary} - Screenshot in binary format.
 func Screenshot(ctx context.Context, args ...core.Value) (core.Value, error) {
 	err := core.ValidateArgs(args, 1, 2)
@@ -29,23 +31,33 @@ func Screenshot(ctx context.Context, args ...core.Value) (core.Value, error) {
 
 	arg1 := args[0]
 
-	err = core.ValidateType(arg1, drivers.HTMLPageType, types.String)
+	err = core.ValidateType(arg1, drivers.HTMLPageType, drivers.HTMLElementType, types.String)
 
 	if err != nil {
 		return values.None, err
 	}
 
-	page, closeAfter, err := OpenOrCastPage(ctx, arg1)
-
-	if err != nil {
-		return values.None, err
+	var target interface {
+		CaptureScreenshot(ctx context.Context, params drivers.ScreenshotParams) (values.Binary, error)
 	}
 
-	defer func() {
-		if closeAfter {
-			page.Close()
+	if el, ok := arg1.(drivers.HTMLElement); ok {
+		target = el
+	} else {
+		page, closeAfter, err := OpenOrCastPage(ctx, arg1)
+
+		if err != nil {
+			return values.None, err
 		}
-	}()
+
+		defer func() {
+			if closeAfter {
+				page.Close()
+			}
+		}()
+
+		target = page
+	}
 
 	screenshotParams := drivers.ScreenshotParams{
 		X:       0,
@@ -82,7 +94,7 @@ func Screenshot(ctx context.Context, args ...core.Value) (core.Value, error) {
 			if !drivers.IsScreenshotFormatValid(format.String()) {
 				return values.None, core.Error(
 					core.ErrInvalidArgument,
-					fmt.Sprintf("format is not valid, expected jpeg or png, but got %s", format.String()))
+					fmt.Sprintf("format is not valid, expected jpeg, png or webp, but got %s", format.String()))
 			}
 
 			screenshotParams.Format = drivers.ScreenshotFormat(format.String())
@@ -155,9 +167,33 @@ func Screenshot(ctx context.Context, args ...core.Value) (core.Value, error) {
 
 			screenshotParams.Quality = quality.(values.Int)
 		}
+
+		fullPage, found := params.Get("fullPage")
+
+		if found {
+			err = core.ValidateType(fullPage, types.Boolean)
+
+			if err != nil {
+				return values.None, err
+			}
+
+			screenshotParams.FullPage = fullPage.(values.Boolean)
+		}
+
+		omitBackground, found := params.Get("omitBackground")
+
+		if found {
+			err = core.ValidateType(omitBackground, types.Boolean)
+
+			if err != nil {
+				return values.None, err
+			}
+
+			screenshotParams.OmitBackground = omitBackground.(values.Boolean)
+		}
 	}
 
-	scr, err := page.CaptureScreenshot(ctx, screenshotParams)
+	scr, err := target.CaptureScreenshot(ctx, screenshotParams)
 
 	if err != nil {
 		return values.None, err
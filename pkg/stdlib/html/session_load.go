@@ -0,0 +1,100 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// SESSION_LOAD opens a new page restoring a session state exported by SESSION_SAVE.
+// Cookies and web storages of the session are set before the page gets loaded.
+// @param {Object} session - Session state.
+// @param {String} [session.url] - Page URL.
+// @param {Object[]} [session.cookies] - Session cookies.
+// @param {Object} [session.storage] - Session web storages.
+// @param {Object} [params] - Page load params. See DOCUMENT for details. Explicitly set values take precedence over the session ones.
+// @return {HTMLPage} - Loaded HTML page.
+func SessionLoad(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 2)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	err = core.ValidateType(args[0], types.Object)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	session, err := parseSession(args[0].(*values.Object))
+
+	if err != nil {
+		return values.None, err
+	}
+
+	var params PageLoadParams
+
+	if len(args) == 1 {
+		params = newDefaultDocLoadParams(values.NewString(session.URL))
+	} else {
+		p, err := newPageLoadParams(values.NewString(session.URL), args[1])
+
+		if err != nil {
+			return values.None, err
+		}
+
+		params = p
+	}
+
+	params.Session = session
+
+	return openPage(ctx, params)
+}
+
+func parseSession(obj *values.Object) (*drivers.Session, error) {
+	res := &drivers.Session{}
+
+	url, exists := obj.Get(values.NewString("url"))
+
+	if exists {
+		if err := core.ValidateType(url, types.String); err != nil {
+			return nil, err
+		}
+
+		res.URL = url.String()
+	}
+
+	cookies, exists := obj.Get(values.NewString("cookies"))
+
+	if exists {
+		if err := core.ValidateType(cookies, types.Array); err != nil {
+			return nil, err
+		}
+
+		c, err := parseCookieArray(cookies.(*values.Array))
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Cookies = c
+	}
+
+	storage, exists := obj.Get(values.NewString("storage"))
+
+	if exists {
+		s, err := parseStorage(storage)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Storage = s
+	}
+
+	return res, nil
+}
@@ -0,0 +1,83 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// SESSION_SAVE exports a session state of a given page: its URL, cookies and web storages.
+// The returned object can be serialized and later passed to SESSION_LOAD.
+// @param {HTMLPage} page - Target page.
+// @return {Object} - Session state.
+func SessionSave(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 1)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	session, err := drivers.SaveSession(ctx, page)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	cookies := values.NewArray(int(session.Cookies.Length()))
+
+	session.Cookies.ForEach(func(value drivers.HTTPCookie, _ values.String) bool {
+		cookies.Push(toCookieObject(value))
+
+		return true
+	})
+
+	local := values.NewObject()
+	sess := values.NewObject()
+
+	if session.Storage != nil {
+		for key, value := range session.Storage.Local {
+			local.Set(values.NewString(key), values.NewString(value))
+		}
+
+		for key, value := range session.Storage.Session {
+			sess.Set(values.NewString(key), values.NewString(value))
+		}
+	}
+
+	return values.NewObjectWith(
+		values.NewObjectProperty("url", values.NewString(session.URL)),
+		values.NewObjectProperty("cookies", cookies),
+		values.NewObjectProperty("storage", values.NewObjectWith(
+			values.NewObjectProperty("local", local),
+			values.NewObjectProperty("session", sess),
+		)),
+	), nil
+}
+
+// toCookieObject converts a cookie to an object in the format accepted by DOCUMENT params.
+func toCookieObject(cookie drivers.HTTPCookie) *values.Object {
+	obj := values.NewObjectWith(
+		values.NewObjectProperty("name", values.NewString(cookie.Name)),
+		values.NewObjectProperty("value", values.NewString(cookie.Value)),
+		values.NewObjectProperty("path", values.NewString(cookie.Path)),
+		values.NewObjectProperty("domain", values.NewString(cookie.Domain)),
+		values.NewObjectProperty("maxAge", values.NewInt(cookie.MaxAge)),
+		values.NewObjectProperty("sameSite", values.NewString(cookie.SameSite.String())),
+		values.NewObjectProperty("httpOnly", values.NewBoolean(cookie.HTTPOnly)),
+		values.NewObjectProperty("secure", values.NewBoolean(cookie.Secure)),
+	)
+
+	if !cookie.Expires.IsZero() {
+		obj.Set("expires", values.NewString(cookie.Expires.Format(values.DefaultTimeLayout)))
+	}
+
+	return obj
+}
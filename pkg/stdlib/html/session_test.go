@@ -0,0 +1,72 @@
+package html_test
+
+import (
+	"context"
+	"encoding/json"
+	h "net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/html"
+)
+
+func TestSession(t *testing.T) {
+	Convey("Should restore a session saved as JSON", t, func() {
+		server := httptest.NewServer(h.HandlerFunc(func(w h.ResponseWriter, r *h.Request) {
+			for _, cookie := range r.Cookies() {
+				h.SetCookie(w, &h.Cookie{Name: cookie.Name, Value: cookie.Value, Path: "/"})
+			}
+
+			_, _ = w.Write([]byte("<html><body>ok</body></html>"))
+		}))
+
+		defer server.Close()
+
+		ctx := drivers.WithContext(context.Background(), http.NewDriver(), drivers.AsDefault())
+
+		page, err := html.Open(ctx, values.NewString(server.URL), values.NewObjectWith(
+			values.NewObjectProperty("cookies", values.NewArrayWith(
+				values.NewObjectWith(
+					values.NewObjectProperty("name", values.NewString("session")),
+					values.NewObjectProperty("value", values.NewString("abc")),
+				),
+			)),
+		))
+
+		So(err, ShouldBeNil)
+
+		defer page.(drivers.HTMLPage).Close()
+
+		session, err := html.SessionSave(ctx, page)
+
+		So(err, ShouldBeNil)
+
+		data, err := session.MarshalJSON()
+
+		So(err, ShouldBeNil)
+
+		var raw interface{}
+
+		So(json.Unmarshal(data, &raw), ShouldBeNil)
+
+		restored, err := html.SessionLoad(ctx, values.Parse(raw))
+
+		So(err, ShouldBeNil)
+
+		defer restored.(drivers.HTMLPage).Close()
+
+		cookies, err := restored.(drivers.HTMLPage).GetCookies(ctx)
+
+		So(err, ShouldBeNil)
+
+		cookie, found := cookies.Get("session")
+
+		So(found, ShouldEqual, values.True)
+		So(cookie.Value, ShouldEqual, "abc")
+	})
+}
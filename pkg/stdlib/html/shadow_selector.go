@@ -0,0 +1,21 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// SHADOW returns QuerySelector of Shadow kind, which pierces open shadow roots.
+// Parts of the selector separated by ">>>" are matched one inside another.
+// @param {String} selector - CSS selector.
+// @return {Any} - Returns QuerySelector of Shadow kind.
+func ShadowSelector(_ context.Context, args ...core.Value) (core.Value, error) {
+	if err := core.ValidateArgs(args, 1, 1); err != nil {
+		return values.None, err
+	}
+
+	return drivers.NewShadowSelector(values.ToString(args[0])), nil
+}
@@ -0,0 +1,34 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// STORAGE_CLEAR removes all items from a web storage of a given page.
+// @param {HTMLPage} page - Target page.
+// @param {String} [type="local"] - Storage type: "local" or "session".
+func StorageClear(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 2)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	storageType, err := toStorageType(args, 1)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return values.None, page.ClearStorage(ctx, storageType)
+}
@@ -0,0 +1,43 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// STORAGE_SET sets items to a web storage of a given page.
+// Existing items with other keys are kept.
+// @param {HTMLPage} page - Target page.
+// @param {Object} items - Items to set. Values are converted to strings.
+// @param {String} [type="local"] - Storage type: "local" or "session".
+func StorageSet(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 3)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	err = core.ValidateType(args[1], types.Object)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	storageType, err := toStorageType(args, 2)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return values.None, page.SetStorage(ctx, storageType, args[1].(*values.Object))
+}
@@ -0,0 +1,70 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// SWIPE dispatches touch swipe gesture starting from a given element.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @param {String | Object} direction - Swipe direction ("left", "right", "up", "down") or an object with "x" and "y" offsets in pixels.
+// @param {Int} [distance=100] - Swipe distance in pixels. Used only with a named direction.
+// @return {Boolean} - True if the gesture was dispatched.
+func Swipe(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 3)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	err = core.ValidateType(args[1], types.String, types.Object)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	if args[1].Type() == types.Object {
+		obj := args[1].(*values.Object)
+		x, _ := obj.Get("x")
+		y, _ := obj.Get("y")
+
+		return values.True, el.Swipe(ctx, values.ToFloat(x), values.ToFloat(y))
+	}
+
+	distance := values.NewFloat(drivers.DefaultSwipeDistance)
+
+	if len(args) > 2 {
+		if err := core.ValidateType(args[2], types.Int, types.Float); err != nil {
+			return values.False, err
+		}
+
+		distance = values.ToFloat(args[2])
+	}
+
+	var x, y values.Float
+
+	switch args[1].String() {
+	case "left":
+		x = -distance
+	case "right":
+		x = distance
+	case "up":
+		y = -distance
+	case "down":
+		y = distance
+	default:
+		return values.False, core.Errorf(core.ErrInvalidArgument, "swipe direction: %s", args[1])
+	}
+
+	return values.True, el.Swipe(ctx, x, y)
+}
@@ -0,0 +1,68 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// SWITCH_TAB brings a given tab to front and returns it.
+// @param {HTMLPage} page - Parent page.
+// @param {Int | HTMLPage} tab - Index of a tab in PAGES(page) or a tab itself.
+// @return {HTMLPage} - Activated tab.
+func SwitchTab(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 2)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	err = core.ValidateType(args[1], types.Int, drivers.HTMLPageType)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	var tab drivers.HTMLPage
+
+	if args[1].Type() == types.Int {
+		pages, err := page.GetPages(ctx)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		idx := args[1].(values.Int)
+
+		if idx < 0 || idx >= pages.Length() {
+			return values.None, core.Errorf(core.ErrInvalidArgument, "tab index out of range: %d", idx)
+		}
+
+		tab, err = drivers.ToPage(pages.Get(idx))
+
+		if err != nil {
+			return values.None, err
+		}
+	} else {
+		tab, err = drivers.ToPage(args[1])
+
+		if err != nil {
+			return values.None, err
+		}
+	}
+
+	if err := tab.BringToFront(ctx); err != nil {
+		return values.None, err
+	}
+
+	return tab, nil
+}
@@ -0,0 +1,28 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// TAP dispatches touch tap on a given element.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @return {Boolean} - True if the tap was dispatched.
+func Tap(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 1)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	return values.True, el.Tap(ctx)
+}
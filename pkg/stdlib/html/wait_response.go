@@ -0,0 +1,116 @@
+package html
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/events"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// WAIT_RESPONSE waits for a response whose url matches a given pattern and returns its body.
+// If the body is a valid JSON, it gets parsed, otherwise it is returned as a string.
+// @param {HTMLPage} page - Target page.
+// @param {String} urlPattern - Regular expression to match a response url against.
+// @param {Int} [timeout=5000] - Wait timeout.
+// @return {Any} - Parsed response body.
+func WaitResponse(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 3)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	page, err := drivers.ToPage(args[0])
+
+	if err != nil {
+		return values.None, err
+	}
+
+	err = core.ValidateType(args[1], types.String)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	pattern, err := regexp.Compile(args[1].String())
+
+	if err != nil {
+		return values.None, errors.Wrap(core.ErrInvalidArgument, err.Error())
+	}
+
+	timeout := values.NewInt(drivers.DefaultWaitTimeout)
+
+	if len(args) > 2 {
+		err = core.ValidateType(args[2], types.Int)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		timeout = args[2].(values.Int)
+	}
+
+	ctx, fn := waitTimeout(ctx, timeout)
+	defer fn()
+
+	stream, err := page.Subscribe(ctx, events.Subscription{
+		EventName: drivers.ResponseEvent,
+	})
+
+	if err != nil {
+		return values.None, err
+	}
+
+	defer stream.Close(ctx)
+
+	resp, err := waitResponse(ctx, stream, pattern)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	body, err := resp.GetBody(ctx)
+
+	if err != nil {
+		return values.None, errors.Wrapf(err, "load response body: %s", resp.URL)
+	}
+
+	out, err := values.Unmarshal(body)
+
+	if err != nil {
+		return values.NewString(string(body)), nil
+	}
+
+	return out, nil
+}
+
+func waitResponse(ctx context.Context, stream events.Stream, pattern *regexp.Regexp) (*drivers.HTTPResponse, error) {
+	messages := stream.Read(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case msg, ok := <-messages:
+			if !ok {
+				return nil, core.ErrTerminated
+			}
+
+			if err := msg.Err(); err != nil {
+				return nil, err
+			}
+
+			resp, ok := msg.Value().(*drivers.HTTPResponse)
+
+			if ok && pattern.MatchString(resp.URL) {
+				return resp, nil
+			}
+		}
+	}
+}
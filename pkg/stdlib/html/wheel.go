@@ -0,0 +1,37 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// WHEEL dispatches mouse wheel event over a given element.
+// @param {HTMLPage | HTMLDocument | HTMLElement} node - Target html node.
+// @param {Int | Float} deltaX - Horizontal scroll delta in pixels.
+// @param {Int | Float} deltaY - Vertical scroll delta in pixels.
+// @return {Boolean} - True if the event was dispatched.
+func Wheel(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 3, 3)
+
+	if err != nil {
+		return values.False, err
+	}
+
+	el, err := drivers.ToElement(args[0])
+
+	if err != nil {
+		return values.False, err
+	}
+
+	for _, arg := range args[1:] {
+		if err := core.ValidateType(arg, types.Int, types.Float); err != nil {
+			return values.False, err
+		}
+	}
+
+	return values.True, el.Wheel(ctx, values.ToFloat(args[1]), values.ToFloat(args[2]))
+}
@@ -0,0 +1,158 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	"image/png"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultThreshold is a default color distance threshold, in [0, 1], below which pixels are considered equal.
+const DefaultThreshold = 0.1
+
+// maxYIQDelta is the maximum possible distance between two colors in YIQ color space.
+const maxYIQDelta = 35215
+
+type Comparison struct {
+	Width            int
+	Height           int
+	TotalPixels      int
+	MismatchedPixels int
+	// Mismatch is a percentage of mismatched pixels, in [0, 100].
+	Mismatch float64
+	// Diff is a PNG image, where mismatched pixels are red and the rest are faded pixels of the first image.
+	Diff []byte
+}
+
+// Compare compares two PNG or JPEG images pixel by pixel.
+// Images of different sizes are compared by the largest size, pixels missing in one of the images are mismatched.
+func Compare(a, b []byte, threshold float64) (*Comparison, error) {
+	if threshold < 0 || threshold > 1 {
+		return nil, errors.Errorf("threshold must be in [0, 1], but got %v", threshold)
+	}
+
+	imgA, _, err := image.Decode(bytes.NewReader(a))
+
+	if err != nil {
+		return nil, errors.Wrap(err, "decode first image")
+	}
+
+	imgB, _, err := image.Decode(bytes.NewReader(b))
+
+	if err != nil {
+		return nil, errors.Wrap(err, "decode second image")
+	}
+
+	boundsA := imgA.Bounds()
+	boundsB := imgB.Bounds()
+	width := maxInt(boundsA.Dx(), boundsB.Dx())
+	height := maxInt(boundsA.Dy(), boundsB.Dy())
+	maxDelta := maxYIQDelta * threshold * threshold
+	diff := image.NewNRGBA(image.Rect(0, 0, width, height))
+	mismatched := 0
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			inA := x < boundsA.Dx() && y < boundsA.Dy()
+			inB := x < boundsB.Dx() && y < boundsB.Dy()
+
+			if !inA || !inB {
+				mismatched++
+				diff.SetNRGBA(x, y, diffColor)
+
+				continue
+			}
+
+			pixelA := toNRGBA(imgA.At(boundsA.Min.X+x, boundsA.Min.Y+y))
+			pixelB := toNRGBA(imgB.At(boundsB.Min.X+x, boundsB.Min.Y+y))
+
+			if colorDelta(pixelA, pixelB) > maxDelta {
+				mismatched++
+				diff.SetNRGBA(x, y, diffColor)
+
+				continue
+			}
+
+			diff.SetNRGBA(x, y, fade(pixelA))
+		}
+	}
+
+	var out bytes.Buffer
+
+	if err := png.Encode(&out, diff); err != nil {
+		return nil, errors.Wrap(err, "encode diff image")
+	}
+
+	total := width * height
+	comparison := &Comparison{
+		Width:            width,
+		Height:           height,
+		TotalPixels:      total,
+		MismatchedPixels: mismatched,
+		Diff:             out.Bytes(),
+	}
+
+	if total > 0 {
+		comparison.Mismatch = float64(mismatched) * 100 / float64(total)
+	}
+
+	return comparison, nil
+}
+
+var diffColor = color.NRGBA{R: 255, A: 255}
+
+func toNRGBA(c color.Color) color.NRGBA {
+	return color.NRGBAModel.Convert(c).(color.NRGBA)
+}
+
+// colorDelta returns a squared distance between two colors in YIQ color space.
+// Colors get blended with white background first, so that transparent pixels are comparable.
+func colorDelta(a, b color.NRGBA) float64 {
+	r1, g1, b1 := blend(a)
+	r2, g2, b2 := blend(b)
+
+	y := rgb2y(r1, g1, b1) - rgb2y(r2, g2, b2)
+	i := rgb2i(r1, g1, b1) - rgb2i(r2, g2, b2)
+	q := rgb2q(r1, g1, b1) - rgb2q(r2, g2, b2)
+
+	return 0.5053*y*y + 0.299*i*i + 0.1957*q*q
+}
+
+func blend(c color.NRGBA) (r, g, b float64) {
+	alpha := float64(c.A) / 255
+
+	return 255 + (float64(c.R)-255)*alpha,
+		255 + (float64(c.G)-255)*alpha,
+		255 + (float64(c.B)-255)*alpha
+}
+
+// fade returns a light grayscale version of a given color.
+func fade(c color.NRGBA) color.NRGBA {
+	r, g, b := blend(c)
+	gray := uint8(255 + (rgb2y(r, g, b)-255)*0.1)
+
+	return color.NRGBA{R: gray, G: gray, B: gray, A: 255}
+}
+
+func rgb2y(r, g, b float64) float64 {
+	return r*0.29889531 + g*0.58662247 + b*0.11448223
+}
+
+func rgb2i(r, g, b float64) float64 {
+	return r*0.59597799 - g*0.27417610 - b*0.32180189
+}
+
+func rgb2q(r, g, b float64) float64 {
+	return r*0.21147017 - g*0.52261711 + b*0.31114694
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,123 @@
+package images_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/images"
+)
+
+func newImage(width, height int, fill color.Color, changed int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if y*width+x < changed {
+				img.Set(x, y, color.Black)
+			} else {
+				img.Set(x, y, fill)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if err := png.Encode(&buf, img); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestCompare(t *testing.T) {
+	Convey("Should match equal images", t, func() {
+		a := newImage(10, 10, color.White, 0)
+
+		out, err := images.Compare(a, a, images.DefaultThreshold)
+
+		So(err, ShouldBeNil)
+		So(out.MismatchedPixels, ShouldEqual, 0)
+		So(out.Mismatch, ShouldEqual, 0)
+		So(out.TotalPixels, ShouldEqual, 100)
+	})
+
+	Convey("Should count mismatched pixels", t, func() {
+		a := newImage(10, 10, color.White, 0)
+		b := newImage(10, 10, color.White, 25)
+
+		out, err := images.Compare(a, b, images.DefaultThreshold)
+
+		So(err, ShouldBeNil)
+		So(out.MismatchedPixels, ShouldEqual, 25)
+		So(out.Mismatch, ShouldEqual, 25)
+
+		diff, err := png.Decode(bytes.NewReader(out.Diff))
+
+		So(err, ShouldBeNil)
+		So(diff.Bounds().Dx(), ShouldEqual, 10)
+
+		r, g, b2, _ := diff.At(0, 0).RGBA()
+
+		So(r>>8, ShouldEqual, 255)
+		So(g, ShouldEqual, 0)
+		So(b2, ShouldEqual, 0)
+	})
+
+	Convey("Should ignore differences below threshold", t, func() {
+		a := newImage(10, 10, color.White, 0)
+		b := newImage(10, 10, color.NRGBA{R: 250, G: 250, B: 250, A: 255}, 0)
+
+		out, err := images.Compare(a, b, images.DefaultThreshold)
+
+		So(err, ShouldBeNil)
+		So(out.MismatchedPixels, ShouldEqual, 0)
+
+		out, err = images.Compare(a, b, 0)
+
+		So(err, ShouldBeNil)
+		So(out.MismatchedPixels, ShouldEqual, 100)
+	})
+
+	Convey("Should treat missing pixels of differently sized images as mismatched", t, func() {
+		a := newImage(10, 10, color.White, 0)
+		b := newImage(10, 5, color.White, 0)
+
+		out, err := images.Compare(a, b, images.DefaultThreshold)
+
+		So(err, ShouldBeNil)
+		So(out.Height, ShouldEqual, 10)
+		So(out.MismatchedPixels, ShouldEqual, 50)
+	})
+
+	Convey("Should return an error for invalid images", t, func() {
+		_, err := images.Compare([]byte("foo"), newImage(1, 1, color.White, 0), images.DefaultThreshold)
+
+		So(err, ShouldBeError)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	Convey("Should return comparison object", t, func() {
+		a := values.NewBinary(newImage(4, 4, color.White, 0))
+		b := values.NewBinary(newImage(4, 4, color.White, 4))
+
+		out, err := images.Diff(context.Background(), a, b, values.NewObjectWith(
+			values.NewObjectProperty("threshold", values.NewFloat(0.2)),
+		))
+
+		So(err, ShouldBeNil)
+
+		obj := out.(*values.Object)
+
+		So(obj.MustGet("mismatch"), ShouldEqual, values.NewFloat(25))
+		So(obj.MustGet("mismatchedPixels"), ShouldEqual, values.NewInt(4))
+		So(obj.MustGet("diff").Type().String(), ShouldEqual, "binary")
+	})
+}
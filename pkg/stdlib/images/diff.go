@@ -0,0 +1,64 @@
+package images
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// IMAGE_DIFF compares two PNG or JPEG images pixel by pixel.
+// @param {Binary} a - First image.
+// @param {Binary} b - Second image.
+// @param {Object} [params] - An object containing the following properties :
+// @param {Float} [params.threshold=0.1] - Color distance, in [0, 1], below which pixels are considered equal.
+// @return {Object} - Object with mismatch percentage, number of mismatched and total pixels, width, height and a diff PNG image.
+func Diff(_ context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 2, 3)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	if err := core.ValidateType(args[0], types.Binary); err != nil {
+		return values.None, err
+	}
+
+	if err := core.ValidateType(args[1], types.Binary); err != nil {
+		return values.None, err
+	}
+
+	threshold := DefaultThreshold
+
+	if len(args) > 2 {
+		if err := core.ValidateType(args[2], types.Object); err != nil {
+			return values.None, err
+		}
+
+		params := args[2].(*values.Object)
+
+		if value, found := params.Get("threshold"); found {
+			if err := core.ValidateType(value, types.Float, types.Int); err != nil {
+				return values.None, err
+			}
+
+			threshold = float64(values.ToFloat(value))
+		}
+	}
+
+	comparison, err := Compare(args[0].(values.Binary), args[1].(values.Binary), threshold)
+
+	if err != nil {
+		return values.None, core.Error(core.ErrInvalidArgument, err.Error())
+	}
+
+	return values.NewObjectWith(
+		values.NewObjectProperty("mismatch", values.NewFloat(comparison.Mismatch)),
+		values.NewObjectProperty("mismatchedPixels", values.NewInt(comparison.MismatchedPixels)),
+		values.NewObjectProperty("totalPixels", values.NewInt(comparison.TotalPixels)),
+		values.NewObjectProperty("width", values.NewInt(comparison.Width)),
+		values.NewObjectProperty("height", values.NewInt(comparison.Height)),
+		values.NewObjectProperty("diff", values.NewBinary(comparison.Diff)),
+	), nil
+}
@@ -0,0 +1,11 @@
+package images
+
+import "github.com/MontFerret/ferret/pkg/runtime/core"
+
+func RegisterLib(ns core.Namespace) error {
+	return ns.RegisterFunctions(
+		core.NewFunctionsFromMap(map[string]core.Function{
+			"IMAGE_DIFF": Diff,
+		}),
+	)
+}
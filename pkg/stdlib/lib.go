@@ -6,6 +6,7 @@ import (
 	"github.com/MontFerret/ferret/pkg/stdlib/collections"
 	"github.com/MontFerret/ferret/pkg/stdlib/datetime"
 	"github.com/MontFerret/ferret/pkg/stdlib/html"
+	"github.com/MontFerret/ferret/pkg/stdlib/images"
 	"github.com/MontFerret/ferret/pkg/stdlib/io"
 	"github.com/MontFerret/ferret/pkg/stdlib/math"
 	"github.com/MontFerret/ferret/pkg/stdlib/objects"
@@ -49,6 +50,10 @@ func RegisterLib(ns core.Namespace) error {
 		return err
 	}
 
+	if err := images.RegisterLib(ns); err != nil {
+		return err
+	}
+
 	if err := io.RegisterLib(ns); err != nil {
 		return err
 	}
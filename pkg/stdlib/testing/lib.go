@@ -35,6 +35,8 @@ func RegisterLib(ns core.Namespace) error {
 			"ARRAY":    base.NewPositiveAssertion(Array),
 			"OBJECT":   base.NewPositiveAssertion(Object),
 			"BINARY":   base.NewPositiveAssertion(Binary),
+
+			"SCREENSHOT_MATCH": base.NewPositiveAssertion(ScreenshotMatch),
 		}),
 	)
 }
@@ -0,0 +1,125 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+	"github.com/MontFerret/ferret/pkg/stdlib/images"
+	"github.com/MontFerret/ferret/pkg/stdlib/testing/base"
+)
+
+// SCREENSHOT_MATCH asserts that a screenshot matches a baseline image stored in a given file.
+// If the baseline file does not exist, the screenshot gets saved as a new baseline.
+// On mismatch, a diff image gets saved next to the baseline with ".diff.png" suffix.
+// @param {Binary} actual - PNG or JPEG screenshot.
+// @param {String} baseline - Path to the baseline image.
+// @param {Object} [params] - An object containing the following properties :
+// @param {Float} [params.threshold=0.1] - Color distance, in [0, 1], below which pixels are considered equal.
+// @param {Float} [params.maxMismatch=0] - Maximum allowed percentage of mismatched pixels, in [0, 100].
+// @param {String} [message] - Message to display on error.
+var ScreenshotMatch = base.Assertion{
+	DefaultMessage: func(args []core.Value) string {
+		return "match screenshot baseline"
+	},
+	MinArgs: 2,
+	MaxArgs: 4,
+	Fn: func(_ context.Context, args []core.Value) (bool, error) {
+		if err := core.ValidateType(args[0], types.Binary); err != nil {
+			return false, err
+		}
+
+		if err := core.ValidateType(args[1], types.String); err != nil {
+			return false, err
+		}
+
+		actual := args[0].(values.Binary)
+		path := args[1].String()
+		threshold := images.DefaultThreshold
+		maxMismatch := 0.0
+
+		if len(args) > 2 && args[2] != values.None {
+			if err := core.ValidateType(args[2], types.Object); err != nil {
+				return false, err
+			}
+
+			params := args[2].(*values.Object)
+
+			if value, found := params.Get("threshold"); found {
+				if err := core.ValidateType(value, types.Float, types.Int); err != nil {
+					return false, err
+				}
+
+				threshold = float64(values.ToFloat(value))
+			}
+
+			if value, found := params.Get("maxMismatch"); found {
+				if err := core.ValidateType(value, types.Float, types.Int); err != nil {
+					return false, err
+				}
+
+				maxMismatch = float64(values.ToFloat(value))
+			}
+		}
+
+		expected, err := os.ReadFile(path)
+
+		if os.IsNotExist(err) {
+			return true, saveBaseline(path, actual)
+		}
+
+		if err != nil {
+			return false, core.Error(err, "read baseline")
+		}
+
+		comparison, err := images.Compare(actual, expected, threshold)
+
+		if err != nil {
+			return false, core.Error(core.ErrInvalidArgument, err.Error())
+		}
+
+		if comparison.Mismatch <= maxMismatch {
+			return true, nil
+		}
+
+		diffPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".diff.png"
+
+		if err := os.WriteFile(diffPath, comparison.Diff, 0644); err != nil {
+			return false, core.Error(err, "write diff image")
+		}
+
+		// a custom message, if any, is reported by the assertion itself
+		if len(args) == 4 {
+			return false, nil
+		}
+
+		// screenshots are too large to be printed, so the default message is reported here
+		return false, core.Error(
+			base.ErrAssertion,
+			fmt.Sprintf(
+				"expected screenshot to match baseline %s, but %.2f%% of pixels differ (allowed %.2f%%), see %s",
+				path,
+				comparison.Mismatch,
+				maxMismatch,
+				diffPath,
+			),
+		)
+	},
+}
+
+func saveBaseline(path string, data values.Binary) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return core.Error(err, "create baseline directory")
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return core.Error(err, "write baseline")
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package testing_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	t "testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/testing"
+	"github.com/MontFerret/ferret/pkg/stdlib/testing/base"
+)
+
+func newScreenshot(fill color.Color) values.Binary {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, fill)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if err := png.Encode(&buf, img); err != nil {
+		panic(err)
+	}
+
+	return values.NewBinary(buf.Bytes())
+}
+
+func TestScreenshotMatch(t *t.T) {
+	ScreenshotMatch := base.NewPositiveAssertion(testing.ScreenshotMatch)
+
+	Convey("When baseline does not exist", t, func() {
+		Convey("It should save the baseline", func() {
+			path := filepath.Join(t.TempDir(), "baselines", "page.png")
+
+			_, err := ScreenshotMatch(context.Background(), newScreenshot(color.White), values.NewString(path))
+
+			So(err, ShouldBeNil)
+
+			_, err = os.Stat(path)
+
+			So(err, ShouldBeNil)
+		})
+	})
+
+	Convey("When screenshot matches baseline", t, func() {
+		Convey("It should pass", func() {
+			path := filepath.Join(t.TempDir(), "page.png")
+
+			So(os.WriteFile(path, newScreenshot(color.White), 0644), ShouldBeNil)
+
+			_, err := ScreenshotMatch(context.Background(), newScreenshot(color.White), values.NewString(path))
+
+			So(err, ShouldBeNil)
+		})
+	})
+
+	Convey("When screenshot does not match baseline", t, func() {
+		Convey("It should return an error and save a diff", func() {
+			path := filepath.Join(t.TempDir(), "page.png")
+
+			So(os.WriteFile(path, newScreenshot(color.White), 0644), ShouldBeNil)
+
+			_, err := ScreenshotMatch(context.Background(), newScreenshot(color.Black), values.NewString(path))
+
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldContainSubstring, "100.00% of pixels differ")
+
+			_, err = os.Stat(filepath.Join(filepath.Dir(path), "page.diff.png"))
+
+			So(err, ShouldBeNil)
+		})
+
+		Convey("It should pass if mismatch is allowed", func() {
+			path := filepath.Join(t.TempDir(), "page.png")
+
+			So(os.WriteFile(path, newScreenshot(color.White), 0644), ShouldBeNil)
+
+			_, err := ScreenshotMatch(
+				context.Background(),
+				newScreenshot(color.Black),
+				values.NewString(path),
+				values.NewObjectWith(values.NewObjectProperty("maxMismatch", values.NewFloat(100))),
+			)
+
+			So(err, ShouldBeNil)
+		})
+
+		Convey("It should use a custom message", func() {
+			path := filepath.Join(t.TempDir(), "page.png")
+
+			So(os.WriteFile(path, newScreenshot(color.White), 0644), ShouldBeNil)
+
+			_, err := ScreenshotMatch(
+				context.Background(),
+				newScreenshot(color.Black),
+				values.NewString(path),
+				values.None,
+				values.NewString("Page has changed"),
+			)
+
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldEqual, base.ErrAssertion.Error()+": Page has changed")
+		})
+	})
+}